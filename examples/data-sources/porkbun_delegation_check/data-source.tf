@@ -0,0 +1,10 @@
+data "porkbun_delegation_check" "example" {
+  domain = porkbun_nameservers.example.domain
+}
+
+check "delegation" {
+  assert {
+    condition     = data.porkbun_delegation_check.example.healthy
+    error_message = "Delegation of example.com is broken: lame ${jsonencode(data.porkbun_delegation_check.example.lame_delegations)}, unreachable ${jsonencode(data.porkbun_delegation_check.example.unreachable_nameservers)}."
+  }
+}
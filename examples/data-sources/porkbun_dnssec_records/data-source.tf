@@ -0,0 +1,7 @@
+data "porkbun_dnssec_records" "example" {
+  domain = "example.com"
+}
+
+output "dnssec_key_tags" {
+  value = [for r in data.porkbun_dnssec_records.example.records : r.key_tag]
+}
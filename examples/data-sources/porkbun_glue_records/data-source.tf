@@ -0,0 +1,7 @@
+data "porkbun_glue_records" "example" {
+  domain = "example.com"
+}
+
+output "glue_addresses" {
+  value = { for g in data.porkbun_glue_records.example.glue_records : g.host => g.ip_addresses }
+}
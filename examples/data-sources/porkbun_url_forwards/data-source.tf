@@ -0,0 +1,7 @@
+data "porkbun_url_forwards" "example" {
+  domain = "example.com"
+}
+
+output "forward_locations" {
+  value = { for f in data.porkbun_url_forwards.example.forwards : f.id => f.location }
+}
@@ -0,0 +1,21 @@
+locals {
+  caa = provider::porkbun::caa({
+    issue = [
+      {
+        domain            = "letsencrypt.org"
+        accounturi        = "https://acme-v02.api.letsencrypt.org/acme/acct/1234567"
+        validationmethods = ["dns-01"]
+      },
+    ]
+    issuewild = [""] # Forbid wildcard certificates.
+    iodef     = ["security@example.com"]
+  })
+}
+
+resource "porkbun_dns_record" "caa" {
+  for_each = toset(local.caa)
+
+  domain  = "example.com"
+  type    = "CAA"
+  content = each.value
+}
@@ -0,0 +1,11 @@
+resource "tls_private_key" "dkim" {
+  algorithm = "RSA"
+  rsa_bits  = 2048
+}
+
+resource "porkbun_dns_record" "dkim" {
+  domain    = "example.com"
+  subdomain = "mail._domainkey"
+  type      = "TXT"
+  content   = provider::porkbun::dkim(tls_private_key.dkim.public_key_pem)
+}
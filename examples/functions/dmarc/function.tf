@@ -0,0 +1,10 @@
+resource "porkbun_dns_record" "dmarc" {
+  domain    = "example.com"
+  subdomain = "_dmarc"
+  type      = "TXT"
+  content   = provider::porkbun::dmarc({
+    p   = "quarantine"
+    pct = 50
+    rua = ["dmarc-reports@example.com"]
+  })
+}
@@ -0,0 +1,23 @@
+locals {
+  ksk = {
+    flags      = 257
+    protocol   = 3
+    algorithm  = 13
+    public_key = "GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA=="
+  }
+}
+
+resource "porkbun_dnssec_record" "example" {
+  domain   = "example.com"
+  key_data = local.ksk
+
+  # Derive the DS record from the DNSKEY using SHA-256.
+  ds_data = provider::porkbun::dnssec_ds(
+    local.ksk.flags,
+    local.ksk.protocol,
+    local.ksk.algorithm,
+    local.ksk.public_key,
+    2,
+    "example.com",
+  )
+}
@@ -0,0 +1,3 @@
+output "dmarc_name" {
+  value = provider::porkbun::fqdn("_dmarc", "example.com") # "_dmarc.example.com"
+}
@@ -0,0 +1,7 @@
+locals {
+  dkim = provider::porkbun::parse_dkim("v=DKIM1; k=ed25519; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=")
+}
+
+output "dkim_key_type" {
+  value = local.dkim.key_type # "ed25519"
+}
@@ -0,0 +1,7 @@
+locals {
+  dmarc = provider::porkbun::parse_dmarc("v=DMARC1; p=reject; rua=mailto:dmarc@example.com")
+}
+
+output "dmarc_policy" {
+  value = local.dmarc.p # "reject"
+}
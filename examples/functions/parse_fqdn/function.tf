@@ -0,0 +1,10 @@
+locals {
+  name = provider::porkbun::parse_fqdn("www.example.co.uk")
+}
+
+resource "porkbun_dns_record" "www" {
+  domain    = local.name.domain    # "example.co.uk"
+  subdomain = local.name.subdomain # "www"
+  type      = "A"
+  content   = "192.0.2.1"
+}
@@ -0,0 +1,14 @@
+locals {
+  records = provider::porkbun::parse_zone_file(file("${path.module}/example.com.zone"), "example.com")
+}
+
+resource "porkbun_dns_record" "migrated" {
+  for_each = { for r in local.records : "${r.type} ${r.subdomain} ${r.content}" => r }
+
+  domain    = "example.com"
+  subdomain = each.value.subdomain
+  type      = each.value.type
+  content   = each.value.content
+  ttl       = each.value.ttl
+  prio      = each.value.prio
+}
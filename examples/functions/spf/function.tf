@@ -0,0 +1,10 @@
+resource "porkbun_dns_record" "spf" {
+  domain  = "example.com"
+  type    = "TXT"
+  content = provider::porkbun::spf({
+    mx      = true
+    ip4     = ["192.0.2.0/24"]
+    include = ["_spf.google.com"]
+    all     = "-all"
+  })
+}
@@ -0,0 +1,10 @@
+data "porkbun_ssl" "mail" {
+  domain = "example.com"
+}
+
+resource "porkbun_dns_record" "tlsa" {
+  domain    = "example.com"
+  subdomain = "_25._tcp.mail"
+  type      = "TLSA"
+  content   = provider::porkbun::tlsa(data.porkbun_ssl.mail.certificate_chain, 3, 1, 1)
+}
@@ -0,0 +1,3 @@
+output "ascii_name" {
+  value = provider::porkbun::to_ascii("bücher.example") # "xn--bcher-kva.example"
+}
@@ -0,0 +1,3 @@
+output "unicode_name" {
+  value = provider::porkbun::to_unicode("xn--bcher-kva.example") # "bücher.example"
+}
@@ -0,0 +1,17 @@
+variable "records" {
+  type = list(object({
+    subdomain = string
+    type      = string
+    content   = string
+    prio      = optional(number)
+  }))
+
+  validation {
+    condition = alltrue([
+      for r in var.records : length(provider::porkbun::validate_record(r.type, r.subdomain, r.content, r.prio)) == 0
+    ])
+    error_message = join("\n", flatten([
+      for r in var.records : provider::porkbun::validate_record(r.type, r.subdomain, r.content, r.prio)
+    ]))
+  }
+}
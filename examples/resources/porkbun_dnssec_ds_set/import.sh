@@ -0,0 +1,2 @@
+# Import by domain name
+terraform import porkbun_dnssec_ds_set.example <domain>
@@ -0,0 +1,15 @@
+# Roll over to a new key signing key: the DS record of the new key is published
+# before the old one is deleted, which happens one day later.
+resource "porkbun_dnssec_ds_set" "example" {
+  domain      = "example.com"
+  hold_period = 86400
+
+  ds_records = [
+    {
+      key_tag     = "64087"
+      algorithm   = 13
+      digest_type = 2
+      digest      = "15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC"
+    },
+  ]
+}
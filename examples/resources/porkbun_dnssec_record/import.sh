@@ -0,0 +1,2 @@
+# Import by the key tag of the DS record, or the key tag computed from the DNSKEY
+terraform import porkbun_dnssec_record.example <domain>:<key_tag>
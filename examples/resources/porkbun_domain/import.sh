@@ -0,0 +1,2 @@
+# Import by the domain name
+terraform import porkbun_domain.example <domain>
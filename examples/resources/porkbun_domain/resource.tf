@@ -0,0 +1,4 @@
+resource "porkbun_domain" "example" {
+  domain     = "example.com"
+  auto_renew = true
+}
@@ -0,0 +1,10 @@
+resource "porkbun_domain_registration" "example" {
+  domain    = "example.com"
+  years     = 2
+  max_price = 25
+}
+
+resource "porkbun_domain" "example" {
+  domain     = porkbun_domain_registration.example.domain
+  auto_renew = true
+}
@@ -0,0 +1,25 @@
+resource "porkbun_email_records" "example" {
+  domain = "example.com"
+  preset = "google_workspace"
+
+  spf_includes = ["mailgun.org"]
+  spf_all      = "-all"
+
+  dkim = [{
+    selector   = "google"
+    public_key = "MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEA..."
+  }]
+
+  dmarc = {
+    policy = "quarantine"
+    rua    = ["dmarc-reports@example.com"]
+  }
+
+  mta_sts = {
+    policy_id = "20240101"
+  }
+
+  tls_rpt = {
+    rua = ["tls-reports@example.com"]
+  }
+}
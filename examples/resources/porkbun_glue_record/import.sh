@@ -0,0 +1,5 @@
+# Import by the fully-qualified host name
+terraform import porkbun_glue_record.example <domain>:<host>
+
+# Import by the host name relative to the domain, e.g. example.com:ns1
+terraform import porkbun_glue_record.example <domain>:<subdomain>
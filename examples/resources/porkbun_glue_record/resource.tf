@@ -0,0 +1,16 @@
+resource "porkbun_glue_record" "ns1" {
+  domain       = "example.com"
+  host         = "ns1.example.com"
+  ip_addresses = ["192.0.2.1", "2001:db8::1"]
+}
+
+resource "porkbun_glue_record" "ns2" {
+  domain       = "example.com"
+  host         = "ns2.example.com"
+  ip_addresses = ["192.0.2.2", "2001:db8::2"]
+}
+
+resource "porkbun_nameservers" "example" {
+  domain      = "example.com"
+  nameservers = [porkbun_glue_record.ns1.host, porkbun_glue_record.ns2.host]
+}
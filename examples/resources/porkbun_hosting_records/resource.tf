@@ -0,0 +1,7 @@
+resource "porkbun_hosting_records" "example" {
+  domain       = "example.com"
+  preset       = "github_pages"
+  target       = "octocat.github.io"
+  verification = "0123456789abcdef0123456789abcd"
+  redirect     = "www_to_apex"
+}
@@ -1,4 +1,7 @@
 resource "porkbun_nameservers" "example" {
   domain      = "example.com"
   nameservers = ["ns1.example.com", "ns2.example.com"]
+
+  # Restore the nameservers in place before Terraform managed them on destroy.
+  on_destroy = "restore_previous"
 }
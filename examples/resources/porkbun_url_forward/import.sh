@@ -1 +1,6 @@
-terraform import porkbun_url_forward.example <domain>/<forward_id>
+# Import by forward ID
+terraform import porkbun_url_forward.example <domain>:<forward_id>
+
+# Import the only forward of a subdomain, leave the subdomain empty for the root domain.
+# A numeric subdomain is only looked up if no forward has that ID.
+terraform import porkbun_url_forward.example <domain>:<subdomain>
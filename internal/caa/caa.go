@@ -0,0 +1,187 @@
+// Package caa builds the content of CAA records (RFC 8659).
+package caa
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// FlagCritical is the issuer critical flag (RFC 8659, section 4.1).
+const FlagCritical = 128
+
+// Tags supported by Policy.
+const (
+	TagIssue     = "issue"
+	TagIssueWild = "issuewild"
+	TagIODEF     = "iodef"
+)
+
+// Issuer describes a certification authority allowed to issue certificates.
+type Issuer struct {
+	// Domain is the issuer domain name of the CA, e.g. "letsencrypt.org".
+	// An empty domain denies issuance by any CA.
+	Domain string
+	// AccountURI restricts issuance to an ACME account (RFC 8657, section 3). It is omitted if empty.
+	AccountURI string
+	// ValidationMethods restricts the domain validation methods (RFC 8657, section 4). It is omitted if empty.
+	ValidationMethods []string
+}
+
+// String returns the quoted value of the issue or issuewild property.
+func (i Issuer) String() string {
+	params := []string{i.Domain}
+	if i.AccountURI != "" {
+		params = append(params, "accounturi="+i.AccountURI)
+	}
+	if len(i.ValidationMethods) > 0 {
+		params = append(params, "validationmethods="+strings.Join(i.ValidationMethods, ","))
+	}
+	if i.Domain == "" && len(params) == 1 {
+		return `";"`
+	}
+	return `"` + strings.Join(params, "; ") + `"`
+}
+
+// Policy describes the CAA records of a domain.
+type Policy struct {
+	// Issue lists the CAs allowed to issue certificates.
+	Issue []Issuer
+	// IssueWild lists the CAs allowed to issue wildcard certificates.
+	IssueWild []Issuer
+	// IODEF lists URIs for violation reports. Addresses without a URI scheme
+	// are treated as mailto URIs.
+	IODEF []string
+	// Critical lists the tags whose records have the critical flag set.
+	Critical []string
+}
+
+// Records returns the content of the CAA records, such as `0 issue "letsencrypt.org"`.
+func (p Policy) Records() []string {
+	var records []string
+	for _, issuer := range p.Issue {
+		records = append(records, p.record(TagIssue, issuer.String()))
+	}
+	for _, issuer := range p.IssueWild {
+		records = append(records, p.record(TagIssueWild, issuer.String()))
+	}
+	for _, uri := range p.IODEF {
+		records = append(records, p.record(TagIODEF, `"`+iodefURI(uri)+`"`))
+	}
+	return records
+}
+
+func (p Policy) record(tag, value string) string {
+	flags := 0
+	if slices.Contains(p.Critical, tag) {
+		flags = FlagCritical
+	}
+	return fmt.Sprintf("%d %s %s", flags, tag, value)
+}
+
+// Validate checks the values of all properties. All problems found are reported in the returned error.
+func (p Policy) Validate() error {
+	var errs []error
+	if len(p.Issue) == 0 && len(p.IssueWild) == 0 && len(p.IODEF) == 0 {
+		errs = append(errs, errors.New("at least one of issue, issuewild or iodef must be set"))
+	}
+	for _, issuer := range p.Issue {
+		if err := issuer.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("issue %q: %w", issuer.Domain, err))
+		}
+	}
+	for _, issuer := range p.IssueWild {
+		if err := issuer.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("issuewild %q: %w", issuer.Domain, err))
+		}
+	}
+	for _, uri := range p.IODEF {
+		if err := validateIODEF(iodefURI(uri)); err != nil {
+			errs = append(errs, fmt.Errorf("iodef %q: %w", uri, err))
+		}
+	}
+	for _, tag := range p.Critical {
+		if tag != TagIssue && tag != TagIssueWild && tag != TagIODEF {
+			errs = append(errs, fmt.Errorf("critical %q: must be one of issue, issuewild or iodef", tag))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// issuerDomainRegexp matches an issuer domain name (RFC 8659, section 4.2).
+var issuerDomainRegexp = regexp.MustCompile(`^[a-z0-9]+(-+[a-z0-9]+)*(\.[a-z0-9]+(-+[a-z0-9]+)*)+$`)
+
+// validationMethodRegexp matches a validation method label (RFC 8657, section 4).
+var validationMethodRegexp = regexp.MustCompile(`^[A-Za-z0-9]+(-[A-Za-z0-9]+)*$`)
+
+func (i Issuer) validate() error {
+	if i.Domain == "" {
+		if i.AccountURI != "" || len(i.ValidationMethods) > 0 {
+			return errors.New("parameters require an issuer domain")
+		}
+		return nil
+	}
+
+	var errs []error
+	if !issuerDomainRegexp.MatchString(i.Domain) {
+		errs = append(errs, errors.New("must be a lower-case domain name in ASCII form"))
+	}
+	if i.AccountURI != "" {
+		if err := validateParameterValue(i.AccountURI); err != nil {
+			errs = append(errs, fmt.Errorf("accounturi: %w", err))
+		} else if u, err := url.Parse(i.AccountURI); err != nil || !u.IsAbs() {
+			errs = append(errs, errors.New("accounturi: must be an absolute URI"))
+		}
+	}
+	for _, method := range i.ValidationMethods {
+		if !validationMethodRegexp.MatchString(method) {
+			errs = append(errs, fmt.Errorf("validationmethods: invalid method %q", method))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validateParameterValue checks that value can be used as a parameter value within a quoted property value.
+func validateParameterValue(value string) error {
+	for _, r := range value {
+		if r <= 0x20 || r >= 0x7f || r == ';' || r == '"' || r == '\\' {
+			return fmt.Errorf("invalid character %q", r)
+		}
+	}
+	return nil
+}
+
+// iodefURI returns the URI, treating addresses without a scheme as mailto URIs.
+func iodefURI(uri string) string {
+	if strings.Contains(uri, ":") {
+		return uri
+	}
+	return "mailto:" + uri
+}
+
+func validateIODEF(uri string) error {
+	if strings.ContainsAny(uri, "\"\\ ") {
+		return errors.New("must not contain quotes, backslashes or spaces")
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return errors.New("invalid URI")
+	}
+	switch u.Scheme {
+	case "mailto":
+		if address, err := mail.ParseAddress(u.Opaque); err != nil || address.Address != u.Opaque {
+			return fmt.Errorf("invalid email address %q", u.Opaque)
+		}
+	case "http", "https":
+		if u.Host == "" {
+			return errors.New("URL must have a host")
+		}
+	default:
+		return errors.New("must be an email address or a mailto, http or https URI")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package caa_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/caa"
+)
+
+func TestPolicy_Records(t *testing.T) {
+	p := caa.Policy{
+		Issue: []caa.Issuer{
+			{Domain: "letsencrypt.org", AccountURI: "https://acme-v02.api.letsencrypt.org/acme/acct/1234", ValidationMethods: []string{"dns-01", "http-01"}},
+			{Domain: "sectigo.com"},
+		},
+		IssueWild: []caa.Issuer{{}},
+		IODEF:     []string{"security@example.com", "https://example.com/caa"},
+		Critical:  []string{"issue"},
+	}
+	if err := p.Validate(); err != nil {
+		t.Fatalf("Validate() unexpected error: %v", err)
+	}
+
+	want := []string{
+		`128 issue "letsencrypt.org; accounturi=https://acme-v02.api.letsencrypt.org/acme/acct/1234; validationmethods=dns-01,http-01"`,
+		`128 issue "sectigo.com"`,
+		`0 issuewild ";"`,
+		`0 iodef "mailto:security@example.com"`,
+		`0 iodef "https://example.com/caa"`,
+	}
+	if got := p.Records(); !slices.Equal(got, want) {
+		t.Errorf("Records() = %q, want %q", got, want)
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy caa.Policy
+	}{
+		{"empty", caa.Policy{}},
+		{"invalid issuer", caa.Policy{Issue: []caa.Issuer{{Domain: "not a domain"}}}},
+		{"quoted issuer", caa.Policy{Issue: []caa.Issuer{{Domain: `"letsencrypt.org"`}}}},
+		{"upper-case issuer", caa.Policy{Issue: []caa.Issuer{{Domain: "LetsEncrypt.org"}}}},
+		{"relative account URI", caa.Policy{Issue: []caa.Issuer{{Domain: "letsencrypt.org", AccountURI: "acct/1234"}}}},
+		{"account URI with semicolon", caa.Policy{Issue: []caa.Issuer{{Domain: "letsencrypt.org", AccountURI: "https://a.example/;x"}}}},
+		{"invalid validation method", caa.Policy{Issue: []caa.Issuer{{Domain: "letsencrypt.org", ValidationMethods: []string{"dns 01"}}}}},
+		{"parameters without issuer", caa.Policy{Issue: []caa.Issuer{{AccountURI: "https://a.example/acct/1"}}}},
+		{"invalid iodef", caa.Policy{IODEF: []string{"ftp://example.com"}}},
+		{"invalid critical tag", caa.Policy{Issue: []caa.Issuer{{Domain: "letsencrypt.org"}}, Critical: []string{"issuemail"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.policy.Validate(); err == nil {
+				t.Error("Validate() expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Package dane builds the content of DANE TLSA records (RFC 6698).
+package dane
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+)
+
+// Certificate usages (RFC 7218, section 2.1).
+const (
+	UsagePKIXTA = 0
+	UsagePKIXEE = 1
+	UsageDANETA = 2
+	UsageDANEEE = 3
+)
+
+// Selectors (RFC 7218, section 2.2).
+const (
+	SelectorCert = 0
+	SelectorSPKI = 1
+)
+
+// Matching types (RFC 7218, section 2.3).
+const (
+	MatchingFull   = 0
+	MatchingSHA256 = 1
+	MatchingSHA512 = 2
+)
+
+// TLSA returns the content of a TLSA record, "<usage> <selector> <matching type> <data>",
+// for a PEM-encoded certificate chain or public key.
+//
+// If data contains several certificates, the first one is used for end-entity usages
+// and the last one for trust anchor usages, so the chain of a certificate issued by
+// a CA can be passed as is. A public key can only be used with the SPKI selector.
+func TLSA(data string, usage, selector, matchingType uint8) (string, error) {
+	if usage > UsageDANEEE {
+		return "", fmt.Errorf("unsupported usage %d, expected 0 to 3", usage)
+	}
+	if selector > SelectorSPKI {
+		return "", fmt.Errorf("unsupported selector %d, expected 0 (full certificate) or 1 (SubjectPublicKeyInfo)", selector)
+	}
+
+	block, err := selectBlock([]byte(data), usage == UsagePKIXTA || usage == UsageDANETA)
+	if err != nil {
+		return "", err
+	}
+
+	var selected []byte
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return "", fmt.Errorf("invalid certificate: %w", err)
+		}
+		if selector == SelectorCert {
+			selected = cert.Raw
+		} else {
+			selected = cert.RawSubjectPublicKeyInfo
+		}
+	case "PUBLIC KEY":
+		if selector != SelectorSPKI {
+			return "", errors.New("a public key can only be used with selector 1 (SubjectPublicKeyInfo)")
+		}
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return "", fmt.Errorf("invalid public key: %w", err)
+		}
+		selected = block.Bytes
+	}
+
+	var digest []byte
+	switch matchingType {
+	case MatchingFull:
+		digest = selected
+	case MatchingSHA256:
+		sum := sha256.Sum256(selected)
+		digest = sum[:]
+	case MatchingSHA512:
+		sum := sha512.Sum512(selected)
+		digest = sum[:]
+	default:
+		return "", fmt.Errorf("unsupported matching type %d, expected 0 (full), 1 (SHA-256) or 2 (SHA-512)", matchingType)
+	}
+
+	return fmt.Sprintf("%d %d %d %s", usage, selector, matchingType, hex.EncodeToString(digest)), nil
+}
+
+// selectBlock returns the first or last certificate or public key block in data.
+func selectBlock(data []byte, last bool) (*pem.Block, error) {
+	var selected *pem.Block
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" && block.Type != "PUBLIC KEY" {
+			continue
+		}
+		selected = block
+		if !last {
+			break
+		}
+	}
+	if selected == nil {
+		return nil, errors.New("no PEM-encoded certificate or public key found")
+	}
+	return selected, nil
+}
@@ -0,0 +1,112 @@
+package dane_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dane"
+)
+
+// newCertificate returns a self-signed certificate for the common name.
+func newCertificate(t *testing.T, commonName string) (*x509.Certificate, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestTLSA(t *testing.T) {
+	leaf, leafPEM := newCertificate(t, "mail.example.com")
+	ca, caPEM := newCertificate(t, "Example CA")
+	chain := leafPEM + caPEM
+	spkiPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: leaf.RawSubjectPublicKeyInfo}))
+
+	// want returns the expected content, computed by the DNS library.
+	want := func(cert *x509.Certificate, usage, selector, matchingType uint8) string {
+		data, err := dns.CertificateToDANE(selector, matchingType, cert)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return fmt.Sprintf("%d %d %d %s", usage, selector, matchingType, data)
+	}
+
+	tests := []struct {
+		name         string
+		data         string
+		usage        uint8
+		selector     uint8
+		matchingType uint8
+		want         string
+	}{
+		{"DANE-EE SPKI SHA-256", leafPEM, 3, 1, 1, want(leaf, 3, 1, 1)},
+		{"DANE-EE cert SHA-512", leafPEM, 3, 0, 2, want(leaf, 3, 0, 2)},
+		{"DANE-EE full SPKI", leafPEM, 3, 1, 0, want(leaf, 3, 1, 0)},
+		{"chain end entity", chain, 3, 1, 1, want(leaf, 3, 1, 1)},
+		{"chain trust anchor", chain, 2, 0, 1, want(ca, 2, 0, 1)},
+		{"public key", spkiPEM, 3, 1, 1, want(leaf, 3, 1, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dane.TLSA(tt.data, tt.usage, tt.selector, tt.matchingType)
+			if err != nil {
+				t.Fatalf("TLSA() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("TLSA() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTLSA_Invalid(t *testing.T) {
+	leaf, leafPEM := newCertificate(t, "mail.example.com")
+	spkiPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: leaf.RawSubjectPublicKeyInfo}))
+
+	tests := []struct {
+		name         string
+		data         string
+		usage        uint8
+		selector     uint8
+		matchingType uint8
+	}{
+		{"no PEM", "not a certificate", 3, 1, 1},
+		{"invalid usage", leafPEM, 4, 1, 1},
+		{"invalid selector", leafPEM, 3, 2, 1},
+		{"invalid matching type", leafPEM, 3, 1, 3},
+		{"public key with cert selector", spkiPEM, 3, 0, 1},
+		{"invalid certificate", "-----BEGIN CERTIFICATE-----\nAAAA\n-----END CERTIFICATE-----\n", 3, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := dane.TLSA(tt.data, tt.usage, tt.selector, tt.matchingType); err == nil {
+				t.Error("TLSA() expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,197 @@
+// Package delegation checks that the nameservers a zone is delegated to serve
+// it authoritatively and consistently.
+package delegation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
+)
+
+// Result is the outcome of querying a single address of a nameserver.
+type Result struct {
+	Nameserver string // Nameserver as given to Check.
+	Address    string // Address queried, in "host:port" form. Empty if the nameserver could not be resolved.
+
+	Reachable     bool     // Whether the address answered at all.
+	Authoritative bool     // Whether the address answered authoritatively with the SOA of the zone.
+	Serial        uint32   // SOA serial, if Authoritative.
+	NS            []string // NS records of the zone served by the address, normalized and sorted.
+	Error         string   // Description of the problem, if any.
+}
+
+// Report summarizes the results of all nameservers.
+type Report struct {
+	Results []Result
+
+	// Lame lists the nameservers that answered without serving the zone
+	// authoritatively on at least one of their addresses.
+	Lame []string
+	// Unreachable lists the nameservers without any address that answered.
+	Unreachable []string
+	// SerialMismatch is set if the authoritative answers disagree on the SOA serial.
+	SerialMismatch bool
+}
+
+// Healthy reports whether every nameserver served the zone with the same serial.
+func (r *Report) Healthy() bool {
+	return len(r.Lame) == 0 && len(r.Unreachable) == 0 && !r.SerialMismatch
+}
+
+// Checker queries nameservers for the SOA and NS records of a zone.
+type Checker struct {
+	Client *resolver.Client
+
+	// Resolvers are the servers used to look up the addresses of nameservers
+	// given by host name. The system resolver is used if empty.
+	Resolvers []string
+}
+
+// Check queries every address of each nameserver. Nameservers may be given as
+// host names or IP addresses, optionally with a port, e.g. "ns1.example.com",
+// "192.0.2.1" or "ns1.example.com:5353".
+func (c *Checker) Check(ctx context.Context, zone string, nameservers []string) Report {
+	var report Report
+	serials := map[uint32]bool{}
+
+	for _, nameserver := range nameservers {
+		addresses, err := c.addresses(ctx, nameserver)
+		if err != nil {
+			report.Results = append(report.Results, Result{Nameserver: nameserver, Error: err.Error()})
+			report.Unreachable = append(report.Unreachable, nameserver)
+			continue
+		}
+
+		reachable, lame := false, false
+		for _, address := range addresses {
+			result := c.query(ctx, zone, nameserver, address)
+			report.Results = append(report.Results, result)
+			reachable = reachable || result.Reachable
+			lame = lame || (result.Reachable && !result.Authoritative)
+			if result.Authoritative {
+				serials[result.Serial] = true
+			}
+		}
+
+		if !reachable {
+			report.Unreachable = append(report.Unreachable, nameserver)
+		} else if lame {
+			report.Lame = append(report.Lame, nameserver)
+		}
+	}
+
+	report.SerialMismatch = len(serials) > 1
+	return report
+}
+
+// query checks a single address of the nameserver.
+func (c *Checker) query(ctx context.Context, zone, nameserver, address string) Result {
+	result := Result{Nameserver: nameserver, Address: address}
+
+	soa, err := c.Client.Query(ctx, address, zone, dns.TypeSOA)
+	if err != nil {
+		var rcodeErr *resolver.RcodeError
+		result.Reachable = errors.As(err, &rcodeErr)
+		result.Error = err.Error()
+		return result
+	}
+	result.Reachable = true
+
+	for _, rr := range soa.Answer {
+		if rr, ok := rr.(*dns.SOA); ok && dns.CanonicalName(rr.Hdr.Name) == dns.CanonicalName(zone) {
+			result.Serial = rr.Serial
+			result.Authoritative = soa.Authoritative
+		}
+	}
+	if !result.Authoritative {
+		result.Error = fmt.Sprintf("%s does not serve the zone %s authoritatively", address, dnsname.Normalize(zone))
+		return result
+	}
+
+	ns, err := c.Client.Query(ctx, address, zone, dns.TypeNS)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	for _, rr := range ns.Answer {
+		if rr, ok := rr.(*dns.NS); ok {
+			result.NS = append(result.NS, dnsname.Normalize(rr.Ns))
+		}
+	}
+	slices.Sort(result.NS)
+
+	return result
+}
+
+// addresses returns the addresses to query for the nameserver in "host:port" form.
+func (c *Checker) addresses(ctx context.Context, nameserver string) ([]string, error) {
+	host, port := nameserver, resolver.DefaultPort
+	if h, p, err := net.SplitHostPort(nameserver); err == nil {
+		host, port = h, p
+	}
+	host = strings.Trim(host, "[]")
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return []string{net.JoinHostPort(addr.String(), port)}, nil
+	}
+
+	ips, err := c.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("nameserver %s has no IPv4 or IPv6 addresses", dnsname.Normalize(host))
+	}
+
+	addresses := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, net.JoinHostPort(ip, port))
+	}
+	return addresses, nil
+}
+
+// lookup returns the IPv4 and IPv6 addresses of the host.
+func (c *Checker) lookup(ctx context.Context, host string) ([]string, error) {
+	if len(c.Resolvers) == 0 {
+		ips, err := net.DefaultResolver.LookupHost(ctx, host)
+		if err != nil {
+			return nil, fmt.Errorf("error looking up nameserver %s: %w", host, err)
+		}
+		return ips, nil
+	}
+
+	var lastErr error
+	for _, server := range c.Resolvers {
+		var ips []string
+		var err error
+		for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+			var resp *dns.Msg
+			resp, err = c.Client.Query(ctx, server, host, qtype)
+			if err != nil {
+				break
+			}
+			for _, rr := range resp.Answer {
+				switch rr := rr.(type) {
+				case *dns.A:
+					ips = append(ips, rr.A.String())
+				case *dns.AAAA:
+					ips = append(ips, rr.AAAA.String())
+				}
+			}
+		}
+		if err == nil {
+			return ips, nil
+		}
+		lastErr = err
+	}
+	return nil, fmt.Errorf("error looking up nameserver %s: %w", host, lastErr)
+}
@@ -0,0 +1,134 @@
+package delegation_test
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/delegation"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver/resolvertest"
+)
+
+// zone returns the SOA and NS records of example.com. with the given serial.
+func zone(serial string) []string {
+	return []string{
+		"example.com. 3600 IN SOA ns1.example.com. hostmaster.example.com. " + serial + " 7200 3600 1209600 3600",
+		"example.com. 3600 IN NS ns1.example.com.",
+		"example.com. 3600 IN NS ns2.example.com.",
+	}
+}
+
+// unusedAddress returns a local address nothing is listening on.
+func unusedAddress(t *testing.T) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := conn.LocalAddr().String()
+	_ = conn.Close()
+	return addr
+}
+
+func TestChecker_Check(t *testing.T) {
+	healthy := resolvertest.NewServer(t, zone("2024010101")...)
+	stale := resolvertest.NewServer(t, zone("2023120101")...)
+	empty := resolvertest.NewServer(t)
+	refused := resolvertest.NewServer(t)
+	refused.SetRcode(dns.RcodeRefused)
+	unreachable := unusedAddress(t)
+
+	checker := &delegation.Checker{Client: resolver.NewClient(200 * time.Millisecond)}
+
+	tests := []struct {
+		name            string
+		nameservers     []string
+		wantLame        []string
+		wantUnreachable []string
+		wantMismatch    bool
+	}{
+		{
+			name:        "healthy",
+			nameservers: []string{healthy.Addr, healthy.Addr},
+		},
+		{
+			name:         "serial mismatch",
+			nameservers:  []string{healthy.Addr, stale.Addr},
+			wantMismatch: true,
+		},
+		{
+			name:        "lame delegation",
+			nameservers: []string{healthy.Addr, empty.Addr, refused.Addr},
+			wantLame:    []string{empty.Addr, refused.Addr},
+		},
+		{
+			name:            "unreachable",
+			nameservers:     []string{healthy.Addr, unreachable},
+			wantUnreachable: []string{unreachable},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := checker.Check(context.Background(), "example.com", tt.nameservers)
+			if !reflect.DeepEqual(report.Lame, tt.wantLame) {
+				t.Errorf("Lame = %v, want %v", report.Lame, tt.wantLame)
+			}
+			if !reflect.DeepEqual(report.Unreachable, tt.wantUnreachable) {
+				t.Errorf("Unreachable = %v, want %v", report.Unreachable, tt.wantUnreachable)
+			}
+			if report.SerialMismatch != tt.wantMismatch {
+				t.Errorf("SerialMismatch = %t, want %t", report.SerialMismatch, tt.wantMismatch)
+			}
+			wantHealthy := tt.wantLame == nil && tt.wantUnreachable == nil && !tt.wantMismatch
+			if report.Healthy() != wantHealthy {
+				t.Errorf("Healthy() = %t, want %t", report.Healthy(), wantHealthy)
+			}
+		})
+	}
+}
+
+func TestChecker_Check_Result(t *testing.T) {
+	server := resolvertest.NewServer(t, zone("2024010101")...)
+	checker := &delegation.Checker{Client: resolver.NewClient(time.Second)}
+
+	report := checker.Check(context.Background(), "example.com.", []string{server.Addr})
+	want := []delegation.Result{{
+		Nameserver:    server.Addr,
+		Address:       server.Addr,
+		Reachable:     true,
+		Authoritative: true,
+		Serial:        2024010101,
+		NS:            []string{"ns1.example.com", "ns2.example.com"},
+	}}
+	if !reflect.DeepEqual(report.Results, want) {
+		t.Errorf("Results = %+v, want %+v", report.Results, want)
+	}
+}
+
+func TestChecker_Check_Resolvers(t *testing.T) {
+	nameserver := resolvertest.NewServer(t, zone("2024010101")...)
+	_, port, _ := net.SplitHostPort(nameserver.Addr)
+	resolverServer := resolvertest.NewServer(t, "ns1.example.net. 600 IN A 127.0.0.1")
+
+	checker := &delegation.Checker{
+		Client:    resolver.NewClient(time.Second),
+		Resolvers: []string{resolverServer.Addr},
+	}
+
+	report := checker.Check(context.Background(), "example.com", []string{
+		net.JoinHostPort("ns1.example.net", port),
+		net.JoinHostPort("ns2.example.net", port),
+	})
+	if len(report.Results) != 2 || !report.Results[0].Authoritative || report.Results[0].Address != nameserver.Addr {
+		t.Errorf("Results = %+v, want ns1.example.net resolved to %s", report.Results, nameserver.Addr)
+	}
+	if want := []string{net.JoinHostPort("ns2.example.net", port)}; !reflect.DeepEqual(report.Unreachable, want) {
+		t.Errorf("Unreachable = %v, want %v", report.Unreachable, want)
+	}
+}
@@ -0,0 +1,132 @@
+// Package dnsname implements the domain name handling shared by the resources
+// and provider functions: splitting names at the registrable domain using the
+// public suffix list, joining subdomains and converting internationalized names.
+package dnsname
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/miekg/dns"
+	"golang.org/x/net/idna"
+	"golang.org/x/net/publicsuffix"
+)
+
+// profile converts between Unicode and ASCII names. Unlike idna.Lookup, it allows
+// underscores and wildcards, which are common in DNS record names.
+var profile = idna.New(
+	idna.MapForLookup(),
+	idna.BidiRule(),
+	idna.Transitional(false),
+	idna.StrictDomainName(false),
+)
+
+// labelRegexp matches a label in ASCII form. Underscores are allowed for
+// service names such as "_dmarc".
+var labelRegexp = regexp.MustCompile(`^[a-z0-9_]([a-z0-9_-]*[a-z0-9_])?$`)
+
+// Name is a fully-qualified domain name split at its registrable domain.
+type Name struct {
+	// Subdomain is the part of the name preceding Domain. It is empty for the domain itself.
+	Subdomain string
+	// Domain is the registrable domain, i.e. the public suffix plus one label.
+	Domain string
+	// TLD is the public suffix of the domain, e.g. "com" or "co.uk".
+	TLD string
+	// Wildcard is true if the first label of the name is "*".
+	Wildcard bool
+}
+
+// Parse splits a fully-qualified domain name into its subdomain, registrable
+// domain and public suffix. The parts are returned in lower-case ASCII form.
+func Parse(fqdn string) (Name, error) {
+	name, err := ToASCII(fqdn)
+	if err != nil {
+		return Name{}, err
+	}
+
+	domain, err := publicsuffix.EffectiveTLDPlusOne(strings.TrimPrefix(name, "*."))
+	if err != nil {
+		return Name{}, fmt.Errorf("%q is a public suffix and has no registrable domain", fqdn)
+	}
+	tld, _ := publicsuffix.PublicSuffix(domain)
+	subdomain, _ := Subdomain(name, domain)
+
+	return Name{
+		Subdomain: subdomain,
+		Domain:    domain,
+		TLD:       tld,
+		Wildcard:  subdomain == "*" || strings.HasPrefix(subdomain, "*."),
+	}, nil
+}
+
+// Normalize returns the name in lower case without a trailing dot.
+func Normalize(name string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+}
+
+// Join returns the fully-qualified name of a subdomain of domain. An empty
+// subdomain refers to the domain itself.
+func Join(subdomain, domain string) string {
+	subdomain = Normalize(subdomain)
+	domain = Normalize(domain)
+	if subdomain == "" {
+		return domain
+	}
+	return subdomain + "." + domain
+}
+
+// Subdomain returns the part of name preceding domain. The second return value
+// is false if name is neither the domain itself nor one of its subdomains.
+func Subdomain(name, domain string) (string, bool) {
+	name = Normalize(name)
+	domain = Normalize(domain)
+	if name == domain {
+		return "", true
+	}
+	subdomain, ok := strings.CutSuffix(name, "."+domain)
+	if !ok || subdomain == "" {
+		return "", false
+	}
+	return subdomain, true
+}
+
+// ToASCII converts an internationalized domain name to its ASCII (punycode) form.
+// The result is normalized and validated as a domain name.
+func ToASCII(name string) (string, error) {
+	name = Normalize(name)
+	if name == "" {
+		return "", errors.New("domain name must not be empty")
+	}
+
+	ascii, err := profile.ToASCII(name)
+	if err != nil {
+		return "", fmt.Errorf("invalid domain name %q: %w", name, err)
+	}
+	if _, ok := dns.IsDomainName(ascii); !ok {
+		return "", fmt.Errorf("invalid domain name %q", name)
+	}
+	for _, label := range strings.Split(ascii, ".") {
+		if label != "*" && !labelRegexp.MatchString(label) {
+			return "", fmt.Errorf("invalid domain name %q: invalid label %q", name, label)
+		}
+	}
+	return ascii, nil
+}
+
+// ToUnicode converts a domain name in ASCII (punycode) form to its Unicode form.
+// The result is normalized and validated as a domain name.
+func ToUnicode(name string) (string, error) {
+	ascii, err := ToASCII(name)
+	if err != nil {
+		return "", err
+	}
+
+	unicode, err := profile.ToUnicode(ascii)
+	if err != nil {
+		return "", fmt.Errorf("invalid domain name %q: %w", name, err)
+	}
+	return unicode, nil
+}
@@ -0,0 +1,130 @@
+package dnsname_test
+
+import (
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		fqdn    string
+		want    dnsname.Name
+		wantErr bool
+	}{
+		{fqdn: "example.com", want: dnsname.Name{Domain: "example.com", TLD: "com"}},
+		{fqdn: "api.eu.example.co.uk", want: dnsname.Name{Subdomain: "api.eu", Domain: "example.co.uk", TLD: "co.uk"}},
+		{fqdn: "WWW.Example.COM.", want: dnsname.Name{Subdomain: "www", Domain: "example.com", TLD: "com"}},
+		{fqdn: "*.example.com", want: dnsname.Name{Subdomain: "*", Domain: "example.com", TLD: "com", Wildcard: true}},
+		{fqdn: "*.dev.example.com", want: dnsname.Name{Subdomain: "*.dev", Domain: "example.com", TLD: "com", Wildcard: true}},
+		{fqdn: "_dmarc.example.com", want: dnsname.Name{Subdomain: "_dmarc", Domain: "example.com", TLD: "com"}},
+		{fqdn: "www.bücher.de", want: dnsname.Name{Subdomain: "www", Domain: "xn--bcher-kva.de", TLD: "de"}},
+		{fqdn: "co.uk", wantErr: true},
+		{fqdn: "", wantErr: true},
+		{fqdn: "foo..example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fqdn, func(t *testing.T) {
+			got, err := dnsname.Parse(tt.fqdn)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		subdomain string
+		domain    string
+		want      string
+	}{
+		{"", "example.com", "example.com"},
+		{"www", "example.com", "www.example.com"},
+		{"API.eu", "Example.co.uk.", "api.eu.example.co.uk"},
+	}
+	for _, tt := range tests {
+		if got := dnsname.Join(tt.subdomain, tt.domain); got != tt.want {
+			t.Errorf("Join(%q, %q) = %q, want %q", tt.subdomain, tt.domain, got, tt.want)
+		}
+	}
+}
+
+func TestSubdomain(t *testing.T) {
+	tests := []struct {
+		name   string
+		domain string
+		want   string
+		wantOK bool
+	}{
+		{"example.com", "example.com", "", true},
+		{"www.example.com", "example.com", "www", true},
+		{"_dmarc.example.co.uk", "example.co.uk", "_dmarc", true},
+		{"a.b.Example.com.", "example.com", "a.b", true},
+		{"*.example.com", "example.com", "*", true},
+		{"example.org", "example.com", "", false},
+		{"notexample.com", "example.com", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := dnsname.Subdomain(tt.name, tt.domain)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("Subdomain() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestToASCII(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "example.com", want: "example.com"},
+		{name: "Bücher.example", want: "xn--bcher-kva.example"},
+		{name: "_dmarc.münchen.de.", want: "_dmarc.xn--mnchen-3ya.de"},
+		{name: "-invalid-.com", wantErr: true},
+		{name: "my host.com", wantErr: true},
+		{name: `"quoted".com`, wantErr: true},
+		{name: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dnsname.ToASCII(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToASCII() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ToASCII() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToUnicode(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "example.com", want: "example.com"},
+		{name: "xn--bcher-kva.example", want: "bücher.example"},
+		{name: "*.XN--MNCHEN-3YA.de", want: "*.münchen.de"},
+		{name: "xn--invalid-.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dnsname.ToUnicode(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ToUnicode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ToUnicode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
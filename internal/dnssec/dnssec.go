@@ -0,0 +1,152 @@
+// Package dnssec implements the computations needed to publish DNSSEC keys at
+// the registry, such as deriving DS records from DNSKEY records.
+package dnssec
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+// Protocol is the only valid value of the DNSKEY protocol field (RFC 4034, section 2.1.2).
+const Protocol = 3
+
+// Digest types supported for DS records.
+const (
+	DigestSHA1   = dns.SHA1
+	DigestSHA256 = dns.SHA256
+	DigestSHA384 = dns.SHA384
+)
+
+// Key describes the RDATA of a DNSKEY record (RFC 4034, section 2).
+type Key struct {
+	Flags     uint16
+	Protocol  uint8
+	Algorithm uint8
+	// PublicKey is the base64-encoded public key.
+	PublicKey string
+}
+
+// DS describes the RDATA of a DS record (RFC 4034, section 5).
+type DS struct {
+	KeyTag     uint16
+	Algorithm  uint8
+	DigestType uint8
+	// Digest is the upper-case hexadecimal digest of the DNSKEY record.
+	Digest string
+}
+
+// Validate checks that the key can be referenced by a DS record.
+func (k Key) Validate() error {
+	var errs []error
+	if k.Flags&dns.ZONE == 0 {
+		errs = append(errs, fmt.Errorf("flags %d: the zone key flag (256) must be set", k.Flags))
+	}
+	if k.Protocol != Protocol {
+		errs = append(errs, fmt.Errorf("protocol %d: must be %d", k.Protocol, Protocol))
+	}
+	if k.PublicKey == "" {
+		errs = append(errs, errors.New("public key must not be empty"))
+	} else if _, err := base64.StdEncoding.DecodeString(k.PublicKey); err != nil {
+		errs = append(errs, errors.New("public key is not valid base64"))
+	}
+	return errors.Join(errs...)
+}
+
+// KeyTag returns the key tag of the key (RFC 4034, appendix B).
+func (k Key) KeyTag() uint16 {
+	return k.rr(".").KeyTag()
+}
+
+// DS returns the DS record referencing the key published at owner, using the
+// digest algorithm identified by digestType (RFC 4034, RFC 4509 and RFC 6605).
+func (k Key) DS(owner string, digestType uint8) (DS, error) {
+	if err := k.Validate(); err != nil {
+		return DS{}, err
+	}
+	switch digestType {
+	case DigestSHA1, DigestSHA256, DigestSHA384:
+	default:
+		return DS{}, fmt.Errorf("unsupported digest type %d, expected %d (SHA-1), %d (SHA-256) or %d (SHA-384)",
+			digestType, DigestSHA1, DigestSHA256, DigestSHA384)
+	}
+
+	name, err := dnsname.ToASCII(owner)
+	if err != nil {
+		return DS{}, err
+	}
+
+	ds := k.rr(dns.Fqdn(name)).ToDS(digestType)
+	if ds == nil {
+		return DS{}, errors.New("failed to compute the digest of the key")
+	}
+	return DS{
+		KeyTag:     ds.KeyTag,
+		Algorithm:  ds.Algorithm,
+		DigestType: ds.DigestType,
+		Digest:     strings.ToUpper(ds.Digest),
+	}, nil
+}
+
+// rr returns the key as a DNSKEY record owned by name.
+func (k Key) rr(name string) *dns.DNSKEY {
+	return &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET},
+		Flags:     k.Flags,
+		Protocol:  k.Protocol,
+		Algorithm: k.Algorithm,
+		PublicKey: k.PublicKey,
+	}
+}
+
+// DigestLength returns the length of the hexadecimal digest of a DS record
+// with the given digest type, and false if the digest type is unknown.
+func DigestLength(digestType uint8) (int, bool) {
+	switch digestType {
+	case DigestSHA1:
+		return 40, true
+	case DigestSHA256, dns.GOST94:
+		return 64, true
+	case DigestSHA384:
+		return 96, true
+	default:
+		return 0, false
+	}
+}
+
+// AlgorithmMustNotSign reports whether RFC 8624, section 3.1, states that
+// zones MUST NOT be signed with the algorithm.
+func AlgorithmMustNotSign(algorithm uint8) bool {
+	switch algorithm {
+	case dns.RSAMD5, dns.DSA, dns.DSANSEC3SHA1, dns.ECCGOST:
+		return true
+	default:
+		return false
+	}
+}
+
+// DigestTypeMustNotDelegate reports whether RFC 8624, section 3.3, states
+// that DS records MUST NOT be created with the digest type.
+func DigestTypeMustNotDelegate(digestType uint8) bool {
+	switch digestType {
+	case DigestSHA1, dns.GOST94:
+		return true
+	default:
+		return false
+	}
+}
+
+// Matches reports whether the DS record references the key published at owner.
+// DS records with digest types not supported by Key.DS never match.
+func (k Key) Matches(ds DS, owner string) bool {
+	computed, err := k.DS(owner, ds.DigestType)
+	if err != nil {
+		return false
+	}
+	return computed.KeyTag == ds.KeyTag && computed.Algorithm == ds.Algorithm && strings.EqualFold(computed.Digest, ds.Digest)
+}
@@ -0,0 +1,149 @@
+package dnssec_test
+
+import (
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnssec"
+)
+
+// rfc4034Key is the example key from RFC 4034, section 5.4.
+var rfc4034Key = dnssec.Key{
+	Flags:     256,
+	Protocol:  3,
+	Algorithm: 5,
+	PublicKey: "AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvx" +
+		"egXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/rljwvFw==",
+}
+
+func TestKey_DS(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        dnssec.Key
+		owner      string
+		digestType uint8
+		want       dnssec.DS
+	}{
+		{
+			name:       "RFC 4034 SHA-1",
+			key:        rfc4034Key,
+			owner:      "dskey.example.com",
+			digestType: dnssec.DigestSHA1,
+			want:       dnssec.DS{KeyTag: 60485, Algorithm: 5, DigestType: 1, Digest: "2BB183AF5F22588179A53B0A98631FAD1A292118"},
+		},
+		{
+			name:       "RFC 4509 SHA-256",
+			key:        rfc4034Key,
+			owner:      "DSKEY.example.com.",
+			digestType: dnssec.DigestSHA256,
+			want: dnssec.DS{KeyTag: 60485, Algorithm: 5, DigestType: 2,
+				Digest: "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A"},
+		},
+		{
+			name: "RFC 6605 SHA-384",
+			key: dnssec.Key{Flags: 257, Protocol: 3, Algorithm: 14,
+				PublicKey: "xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8/uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40"},
+			owner:      "example.net",
+			digestType: dnssec.DigestSHA384,
+			want: dnssec.DS{KeyTag: 10771, Algorithm: 14, DigestType: 4,
+				Digest: "72D7B62976CE06438E9C0BF319013CF801F09ECC84B8D7E9495F27E305C6A9B0563A9B5F4D288405C3008A946DF983D6"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.key.DS(tt.owner, tt.digestType)
+			if err != nil {
+				t.Fatalf("DS() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("DS() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKey_DS_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        dnssec.Key
+		owner      string
+		digestType uint8
+	}{
+		{"GOST digest", rfc4034Key, "example.com", 3},
+		{"unknown digest", rfc4034Key, "example.com", 99},
+		{"not a zone key", dnssec.Key{Flags: 0, Protocol: 3, Algorithm: 13, PublicKey: "AAAA"}, "example.com", 2},
+		{"invalid protocol", dnssec.Key{Flags: 257, Protocol: 2, Algorithm: 13, PublicKey: "AAAA"}, "example.com", 2},
+		{"invalid base64", dnssec.Key{Flags: 257, Protocol: 3, Algorithm: 13, PublicKey: "not base64!"}, "example.com", 2},
+		{"invalid owner", rfc4034Key, "foo..example.com", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.key.DS(tt.owner, tt.digestType); err == nil {
+				t.Error("DS() expected an error")
+			}
+		})
+	}
+}
+
+func TestKey_KeyTag(t *testing.T) {
+	if got := rfc4034Key.KeyTag(); got != 60485 {
+		t.Errorf("KeyTag() = %d, want 60485", got)
+	}
+}
+
+func TestKey_Matches(t *testing.T) {
+	ds := dnssec.DS{KeyTag: 60485, Algorithm: 5, DigestType: 1, Digest: "2bb183af5f22588179a53b0a98631fad1a292118"}
+	if !rfc4034Key.Matches(ds, "dskey.example.com") {
+		t.Error("Matches() = false, want true")
+	}
+	if rfc4034Key.Matches(ds, "example.com") {
+		t.Error("Matches() with another owner = true, want false")
+	}
+
+	gost := dnssec.DS{KeyTag: 60485, Algorithm: 5, DigestType: 3, Digest: "00"}
+	if rfc4034Key.Matches(gost, "dskey.example.com") {
+		t.Error("Matches() with an unsupported digest type = true, want false")
+	}
+}
+
+func TestDigestLength(t *testing.T) {
+	tests := []struct {
+		digestType uint8
+		want       int
+		wantOK     bool
+	}{
+		{dnssec.DigestSHA1, 40, true},
+		{dnssec.DigestSHA256, 64, true},
+		{3, 64, true},
+		{dnssec.DigestSHA384, 96, true},
+		{99, 0, false},
+	}
+	for _, tt := range tests {
+		if got, ok := dnssec.DigestLength(tt.digestType); got != tt.want || ok != tt.wantOK {
+			t.Errorf("DigestLength(%d) = %d, %v, want %d, %v", tt.digestType, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestMustNot(t *testing.T) {
+	for _, algorithm := range []uint8{1, 3, 6, 12} {
+		if !dnssec.AlgorithmMustNotSign(algorithm) {
+			t.Errorf("AlgorithmMustNotSign(%d) = false, want true", algorithm)
+		}
+	}
+	for _, algorithm := range []uint8{5, 8, 10, 13, 14, 15, 16} {
+		if dnssec.AlgorithmMustNotSign(algorithm) {
+			t.Errorf("AlgorithmMustNotSign(%d) = true, want false", algorithm)
+		}
+	}
+
+	for _, digestType := range []uint8{1, 3} {
+		if !dnssec.DigestTypeMustNotDelegate(digestType) {
+			t.Errorf("DigestTypeMustNotDelegate(%d) = false, want true", digestType)
+		}
+	}
+	for _, digestType := range []uint8{2, 4} {
+		if dnssec.DigestTypeMustNotDelegate(digestType) {
+			t.Errorf("DigestTypeMustNotDelegate(%d) = true, want false", digestType)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package dnssec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
+)
+
+// LookupKeys queries the DNSKEY records of the zone, trying each server in
+// turn until one answers.
+func LookupKeys(ctx context.Context, client *resolver.Client, servers []string, zone string) ([]Key, error) {
+	if len(servers) == 0 {
+		return nil, errors.New("no servers to query for DNSKEY records")
+	}
+
+	var lastErr error
+	for _, server := range servers {
+		resp, err := client.Query(ctx, server, zone, dns.TypeDNSKEY)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		var keys []Key
+		for _, rr := range resp.Answer {
+			if rr, ok := rr.(*dns.DNSKEY); ok && dns.CanonicalName(rr.Hdr.Name) == dns.CanonicalName(zone) {
+				keys = append(keys, Key{Flags: rr.Flags, Protocol: rr.Protocol, Algorithm: rr.Algorithm, PublicKey: rr.PublicKey})
+			}
+		}
+		return keys, nil
+	}
+	return nil, fmt.Errorf("error looking up DNSKEY records of %s: %w", zone, lastErr)
+}
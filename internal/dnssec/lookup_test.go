@@ -0,0 +1,35 @@
+package dnssec_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnssec"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver/resolvertest"
+)
+
+func TestLookupKeys(t *testing.T) {
+	server := resolvertest.NewServer(t,
+		"dskey.example.com. 86400 IN DNSKEY 256 3 5 "+rfc4034Key.PublicKey,
+		"other.example.com. 86400 IN DNSKEY 257 3 13 AAAA",
+	)
+	failing := resolvertest.NewServer(t)
+	failing.SetRcode(dns.RcodeServerFailure)
+
+	client := resolver.NewClient(time.Second)
+	keys, err := dnssec.LookupKeys(context.Background(), client, []string{failing.Addr, server.Addr}, "DSKEY.example.com")
+	if err != nil {
+		t.Fatalf("LookupKeys() unexpected error: %v", err)
+	}
+	if len(keys) != 1 || keys[0] != rfc4034Key {
+		t.Errorf("LookupKeys() = %+v, want %+v", keys, rfc4034Key)
+	}
+
+	if _, err := dnssec.LookupKeys(context.Background(), client, []string{failing.Addr}, "dskey.example.com"); err == nil {
+		t.Error("LookupKeys() expected an error if no server answers")
+	}
+}
@@ -0,0 +1,118 @@
+// Package hostnametypes implements a custom Terraform string type for host
+// names, such as nameservers, that are compared case-insensitively and
+// regardless of a trailing dot.
+package hostnametypes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+var (
+	_ basetypes.StringTypable                    = (*HostnameType)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Hostname)(nil)
+)
+
+// HostnameType is the attribute type of Hostname values.
+type HostnameType struct {
+	basetypes.StringType
+}
+
+func (t HostnameType) String() string {
+	return "hostnametypes.HostnameType"
+}
+
+func (t HostnameType) ValueType(ctx context.Context) attr.Value {
+	return Hostname{}
+}
+
+func (t HostnameType) Equal(o attr.Type) bool {
+	other, ok := o.(HostnameType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t HostnameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return Hostname{StringValue: in}, nil
+}
+
+func (t HostnameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+	return stringValuable, nil
+}
+
+// Hostname is a host name that is semantically equal to the same name in
+// another case or with a trailing dot.
+type Hostname struct {
+	basetypes.StringValue
+}
+
+func (v Hostname) Type(ctx context.Context) attr.Type {
+	return HostnameType{}
+}
+
+func (v Hostname) Equal(o attr.Value) bool {
+	other, ok := o.(Hostname)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both host names are equal once normalized.
+func (v Hostname) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Hostname)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return v.Normalized() == newValue.Normalized(), diags
+}
+
+// Normalized returns the host name in lower case without a trailing dot.
+func (v Hostname) Normalized() string {
+	return dnsname.Normalize(v.ValueString())
+}
+
+// NewHostnameValue creates a known Hostname with the given value.
+func NewHostnameValue(value string) Hostname {
+	return Hostname{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewHostnameNull creates a null Hostname.
+func NewHostnameNull() Hostname {
+	return Hostname{StringValue: basetypes.NewStringNull()}
+}
+
+// NewHostnameUnknown creates an unknown Hostname.
+func NewHostnameUnknown() Hostname {
+	return Hostname{StringValue: basetypes.NewStringUnknown()}
+}
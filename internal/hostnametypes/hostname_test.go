@@ -0,0 +1,51 @@
+package hostnametypes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/hostnametypes"
+)
+
+func TestHostname_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  string
+		proposed string
+		want     bool
+	}{
+		"equal":          {current: "ns1.example.com", proposed: "ns1.example.com", want: true},
+		"case":           {current: "NS1.Example.com", proposed: "ns1.example.com", want: true},
+		"trailing dot":   {current: "ns1.example.com.", proposed: "ns1.example.com", want: true},
+		"different host": {current: "ns1.example.com", proposed: "ns2.example.com"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := hostnametypes.NewHostnameValue(tc.current).StringSemanticEquals(context.Background(), hostnametypes.NewHostnameValue(tc.proposed))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if got != tc.want {
+				t.Errorf("StringSemanticEquals() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHostnameType_ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+
+	got, err := hostnametypes.HostnameType{}.ValueFromTerraform(context.Background(), tftypes.NewValue(tftypes.String, "ns1.example.com"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := hostnametypes.NewHostnameValue("ns1.example.com"); !got.Equal(want) {
+		t.Errorf("ValueFromTerraform() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,185 @@
+package mailauth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// minDKIMRSAKeyBits is the minimum size of RSA keys that verifiers must accept (RFC 8301, section 3.2).
+const minDKIMRSAKeyBits = 1024
+
+// DKIMKey describes a DKIM key record (RFC 6376, section 3.6.1).
+type DKIMKey struct {
+	// KeyType is the "k" tag, "rsa" or "ed25519".
+	KeyType string
+	// PublicKey is the base64-encoded public key. An empty key means the key was revoked.
+	PublicKey string
+	// HashAlgorithms is the "h" tag. It is omitted if empty.
+	HashAlgorithms []string
+	// ServiceTypes is the "s" tag. It is omitted if empty.
+	ServiceTypes []string
+	// Flags is the "t" tag. It is omitted if empty.
+	Flags []string
+	// Notes is the "n" tag. It is omitted if empty.
+	Notes string
+}
+
+// String returns the DKIM key record value.
+func (k DKIMKey) String() string {
+	tags := []string{"v=DKIM1", "k=" + k.KeyType}
+	if len(k.HashAlgorithms) > 0 {
+		tags = append(tags, "h="+strings.Join(k.HashAlgorithms, ":"))
+	}
+	if len(k.ServiceTypes) > 0 {
+		tags = append(tags, "s="+strings.Join(k.ServiceTypes, ":"))
+	}
+	if len(k.Flags) > 0 {
+		tags = append(tags, "t="+strings.Join(k.Flags, ":"))
+	}
+	if k.Notes != "" {
+		tags = append(tags, "n="+k.Notes)
+	}
+	tags = append(tags, "p="+k.PublicKey)
+	return strings.Join(tags, "; ")
+}
+
+// Validate checks the key type and that the public key is a base64-encoded
+// key of that type. An empty public key is accepted, as it revokes the key.
+func (k DKIMKey) Validate() error {
+	if k.KeyType != "rsa" && k.KeyType != "ed25519" {
+		return fmt.Errorf("unsupported key type %q, expected rsa or ed25519", k.KeyType)
+	}
+	if k.PublicKey == "" {
+		return nil
+	}
+	der, err := base64.StdEncoding.DecodeString(k.PublicKey)
+	if err != nil {
+		return errors.New("public key is not valid base64")
+	}
+
+	if k.KeyType == "ed25519" {
+		if len(der) != ed25519.PublicKeySize {
+			return fmt.Errorf("Ed25519 public key has %d bytes, expected %d", len(der), ed25519.PublicKeySize)
+		}
+		return nil
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return fmt.Errorf("invalid RSA public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("public key is a %T, expected an RSA key", key)
+	}
+	if rsaKey.N.BitLen() < minDKIMRSAKeyBits {
+		return fmt.Errorf("RSA key has %d bits, but at least %d are required", rsaKey.N.BitLen(), minDKIMRSAKeyBits)
+	}
+	return nil
+}
+
+// DKIM returns the value of a DKIM key record (RFC 6376) for a base64-encoded public key.
+func DKIM(keyType, publicKey string) string {
+	return DKIMKey{KeyType: keyType, PublicKey: publicKey}.String()
+}
+
+// DKIMKeyFromPEM returns the DKIM key for a PEM-encoded RSA or Ed25519 public key.
+//
+// RSA keys are published as DER-encoded SubjectPublicKeyInfo, and Ed25519 keys as
+// the raw 32-byte public key (RFC 8463).
+func DKIMKeyFromPEM(data string) (DKIMKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(data)))
+	if block == nil {
+		return DKIMKey{}, errors.New("no PEM-encoded public key found")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return DKIMKey{}, fmt.Errorf("unsupported PEM block type %q, expected a public key", block.Type)
+	}
+	if err != nil {
+		return DKIMKey{}, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minDKIMRSAKeyBits {
+			return DKIMKey{}, fmt.Errorf("RSA key has %d bits, but at least %d are required", key.N.BitLen(), minDKIMRSAKeyBits)
+		}
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			return DKIMKey{}, err
+		}
+		return DKIMKey{KeyType: "rsa", PublicKey: base64.StdEncoding.EncodeToString(der)}, nil
+	case ed25519.PublicKey:
+		return DKIMKey{KeyType: "ed25519", PublicKey: base64.StdEncoding.EncodeToString(key)}, nil
+	default:
+		return DKIMKey{}, fmt.Errorf("unsupported key type %T, expected RSA or Ed25519", key)
+	}
+}
+
+// ParseDKIM parses the value of a DKIM key record. The key type defaults to
+// "rsa" if the record has no "k" tag, and unknown tags are ignored.
+func ParseDKIM(value string) (DKIMKey, error) {
+	tags, err := parseTagList(value)
+	if err != nil {
+		return DKIMKey{}, err
+	}
+	if tags[0].name == "v" && tags[0].value != "DKIM1" {
+		return DKIMKey{}, fmt.Errorf("unsupported version %q", tags[0].value)
+	}
+
+	k := DKIMKey{KeyType: "rsa"}
+	hasKey := false
+	for i, t := range tags {
+		switch t.name {
+		case "v":
+			if i != 0 {
+				return DKIMKey{}, errors.New("the v tag must be the first tag")
+			}
+		case "k":
+			k.KeyType = t.value
+		case "p":
+			// Whitespace may be used to fold the base64-encoded key.
+			k.PublicKey = strings.Join(strings.Fields(t.value), "")
+			hasKey = true
+		case "h":
+			k.HashAlgorithms = splitColonList(t.value)
+		case "s":
+			k.ServiceTypes = splitColonList(t.value)
+		case "t":
+			k.Flags = splitColonList(t.value)
+		case "n":
+			k.Notes = t.value
+		}
+	}
+	if !hasKey {
+		return DKIMKey{}, errors.New("record is missing the required p tag")
+	}
+	if _, err := base64.StdEncoding.DecodeString(k.PublicKey); err != nil {
+		return DKIMKey{}, errors.New("p tag is not valid base64")
+	}
+	return k, nil
+}
+
+// splitColonList splits a colon-separated list, removing surrounding whitespace.
+func splitColonList(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ":") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
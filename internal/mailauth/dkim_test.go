@@ -0,0 +1,206 @@
+package mailauth_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+)
+
+func TestDKIM(t *testing.T) {
+	if got, want := mailauth.DKIM("rsa", "MIIB"), "v=DKIM1; k=rsa; p=MIIB"; got != want {
+		t.Errorf("DKIM() = %v, want %v", got, want)
+	}
+}
+
+func TestDKIMKey_String(t *testing.T) {
+	key := mailauth.DKIMKey{
+		KeyType:        "rsa",
+		PublicKey:      "MIIB",
+		HashAlgorithms: []string{"sha256"},
+		Flags:          []string{"y", "s"},
+	}
+	if got, want := key.String(), "v=DKIM1; k=rsa; h=sha256; t=y:s; p=MIIB"; got != want {
+		t.Errorf("String() = %v, want %v", got, want)
+	}
+}
+
+func TestDKIMKeyFromPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edDER, err := x509.MarshalPKIXPublicKey(edKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// crypto/rsa refuses to generate keys this small, so construct one directly.
+	smallKey := &rsa.PublicKey{N: new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 511), big.NewInt(1)), E: 65537}
+	encode := func(blockType string, der []byte) string {
+		return string(pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}))
+	}
+
+	tests := []struct {
+		name    string
+		pem     string
+		want    mailauth.DKIMKey
+		wantErr string
+	}{
+		{
+			name: "rsa",
+			pem:  encode("PUBLIC KEY", rsaDER),
+			want: mailauth.DKIMKey{KeyType: "rsa", PublicKey: base64.StdEncoding.EncodeToString(rsaDER)},
+		},
+		{
+			name: "rsa pkcs1",
+			pem:  encode("RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)),
+			want: mailauth.DKIMKey{KeyType: "rsa", PublicKey: base64.StdEncoding.EncodeToString(rsaDER)},
+		},
+		{
+			name: "ed25519",
+			pem:  encode("PUBLIC KEY", edDER),
+			want: mailauth.DKIMKey{KeyType: "ed25519", PublicKey: base64.StdEncoding.EncodeToString(edKey)},
+		},
+		{name: "not pem", pem: "MIIB", wantErr: "no PEM-encoded public key found"},
+		{name: "private key", pem: encode("PRIVATE KEY", rsaDER), wantErr: `unsupported PEM block type "PRIVATE KEY"`},
+		{name: "small rsa key", pem: encode("RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(smallKey)), wantErr: "RSA key has 512 bits"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mailauth.DKIMKeyFromPEM(tt.pem)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("DKIMKeyFromPEM() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DKIMKeyFromPEM() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DKIMKeyFromPEM() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDKIMKey_Validate(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaDER, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	smallKey := &rsa.PublicKey{N: new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 511), big.NewInt(1)), E: 65537}
+	smallDER, err := x509.MarshalPKIXPublicKey(smallKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKeyData := base64.StdEncoding.EncodeToString(rsaDER)
+	edKeyData := base64.StdEncoding.EncodeToString(edKey)
+
+	tests := []struct {
+		name    string
+		key     mailauth.DKIMKey
+		wantErr string
+	}{
+		{"rsa", mailauth.DKIMKey{KeyType: "rsa", PublicKey: rsaKeyData}, ""},
+		{"ed25519", mailauth.DKIMKey{KeyType: "ed25519", PublicKey: edKeyData}, ""},
+		{"revoked", mailauth.DKIMKey{KeyType: "rsa"}, ""},
+		{"unsupported key type", mailauth.DKIMKey{KeyType: "dsa", PublicKey: rsaKeyData}, `unsupported key type "dsa"`},
+		{"not base64", mailauth.DKIMKey{KeyType: "rsa", PublicKey: "not base64!"}, "not valid base64"},
+		{"truncated rsa key", mailauth.DKIMKey{KeyType: "rsa", PublicKey: "MIIB"}, "invalid RSA public key"},
+		{"ed25519 key as rsa", mailauth.DKIMKey{KeyType: "rsa", PublicKey: edKeyData}, "invalid RSA public key"},
+		{"rsa key as ed25519", mailauth.DKIMKey{KeyType: "ed25519", PublicKey: rsaKeyData}, "Ed25519 public key has"},
+		{"small rsa key", mailauth.DKIMKey{KeyType: "rsa", PublicKey: base64.StdEncoding.EncodeToString(smallDER)}, "RSA key has 512 bits"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.key.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDKIM(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    mailauth.DKIMKey
+		wantErr string
+	}{
+		{
+			name:  "full",
+			value: "v=DKIM1; k=ed25519; h=sha256; s=email; t=s; n=note; p=11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=",
+			want: mailauth.DKIMKey{
+				KeyType:        "ed25519",
+				PublicKey:      "11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=",
+				HashAlgorithms: []string{"sha256"},
+				ServiceTypes:   []string{"email"},
+				Flags:          []string{"s"},
+				Notes:          "note",
+			},
+		},
+		{
+			name:  "default key type and folded key",
+			value: `"p=MIGfMA0GCSqGSIb3 " "DQEBAQUAA4GNADCBiQKBgQ=="`,
+			want:  mailauth.DKIMKey{KeyType: "rsa", PublicKey: "MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQ=="},
+		},
+		{
+			name:  "revoked",
+			value: "v=DKIM1; p=",
+			want:  mailauth.DKIMKey{KeyType: "rsa"},
+		},
+		{name: "missing key", value: "v=DKIM1; k=rsa", wantErr: "missing the required p tag"},
+		{name: "invalid version", value: "v=DKIM2; p=", wantErr: `unsupported version "DKIM2"`},
+		{name: "version not first", value: "k=rsa; v=DKIM1; p=", wantErr: "v tag must be the first tag"},
+		{name: "invalid key", value: "p=not base64!", wantErr: "not valid base64"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mailauth.ParseDKIM(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseDKIM() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDKIM() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDKIM() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
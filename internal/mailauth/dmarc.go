@@ -0,0 +1,170 @@
+package mailauth
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DMARC describes a DMARC policy record (RFC 7489).
+type DMARC struct {
+	// Policy is the requested handling for failing mail: none, quarantine or reject.
+	Policy string
+	// SubdomainPolicy is the policy for subdomains. It is omitted if empty.
+	SubdomainPolicy string
+	// Percent is the percentage of messages the policy applies to. It is omitted if nil.
+	Percent *int64
+	// RUA and RUF list the addresses for aggregate and failure reports.
+	// Addresses without a URI scheme are treated as mailto URIs.
+	RUA []string
+	RUF []string
+	// ADKIM and ASPF are the DKIM and SPF alignment modes ("r" or "s"). They are omitted if empty.
+	ADKIM string
+	ASPF  string
+	// FailureOptions is the "fo" tag. It is omitted if empty.
+	FailureOptions string
+}
+
+// String returns the DMARC record value.
+func (d DMARC) String() string {
+	tags := []string{"v=DMARC1", "p=" + d.Policy}
+	if d.SubdomainPolicy != "" {
+		tags = append(tags, "sp="+d.SubdomainPolicy)
+	}
+	if d.Percent != nil {
+		tags = append(tags, "pct="+strconv.FormatInt(*d.Percent, 10))
+	}
+	if len(d.RUA) > 0 {
+		tags = append(tags, "rua="+joinURIs(d.RUA))
+	}
+	if len(d.RUF) > 0 {
+		tags = append(tags, "ruf="+joinURIs(d.RUF))
+	}
+	if d.ADKIM != "" {
+		tags = append(tags, "adkim="+d.ADKIM)
+	}
+	if d.ASPF != "" {
+		tags = append(tags, "aspf="+d.ASPF)
+	}
+	if d.FailureOptions != "" {
+		tags = append(tags, "fo="+d.FailureOptions)
+	}
+	return strings.Join(tags, "; ")
+}
+
+// Validate checks the values of all tags. All problems found are reported in the returned error.
+func (d DMARC) Validate() error {
+	var errs []error
+	if !isDMARCPolicy(d.Policy) {
+		errs = append(errs, fmt.Errorf("p %q: must be one of none, quarantine or reject", d.Policy))
+	}
+	if d.SubdomainPolicy != "" && !isDMARCPolicy(d.SubdomainPolicy) {
+		errs = append(errs, fmt.Errorf("sp %q: must be one of none, quarantine or reject", d.SubdomainPolicy))
+	}
+	if d.Percent != nil && (*d.Percent < 0 || *d.Percent > 100) {
+		errs = append(errs, fmt.Errorf("pct %d: must be between 0 and 100", *d.Percent))
+	}
+	for _, address := range d.RUA {
+		if err := validateDMARCURI(MailtoURI(address)); err != nil {
+			errs = append(errs, fmt.Errorf("rua %q: %w", address, err))
+		}
+	}
+	for _, address := range d.RUF {
+		if err := validateDMARCURI(MailtoURI(address)); err != nil {
+			errs = append(errs, fmt.Errorf("ruf %q: %w", address, err))
+		}
+	}
+	if d.ADKIM != "" && d.ADKIM != "r" && d.ADKIM != "s" {
+		errs = append(errs, fmt.Errorf("adkim %q: must be r or s", d.ADKIM))
+	}
+	if d.ASPF != "" && d.ASPF != "r" && d.ASPF != "s" {
+		errs = append(errs, fmt.Errorf("aspf %q: must be r or s", d.ASPF))
+	}
+	if d.FailureOptions != "" {
+		for _, option := range strings.Split(d.FailureOptions, ":") {
+			if option != "0" && option != "1" && option != "d" && option != "s" {
+				errs = append(errs, fmt.Errorf("fo %q: must be a colon-separated list of 0, 1, d and s", d.FailureOptions))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ParseDMARC parses the value of a DMARC record. The record is validated, and
+// tags that are not supported by DMARC are ignored.
+func ParseDMARC(value string) (DMARC, error) {
+	tags, err := parseTagList(value)
+	if err != nil {
+		return DMARC{}, err
+	}
+	if tags[0].name != "v" || tags[0].value != "DMARC1" {
+		return DMARC{}, errors.New("record must start with v=DMARC1")
+	}
+
+	var d DMARC
+	hasPolicy := false
+	for _, t := range tags[1:] {
+		switch t.name {
+		case "p":
+			d.Policy = t.value
+			hasPolicy = true
+		case "sp":
+			d.SubdomainPolicy = t.value
+		case "pct":
+			pct, err := strconv.ParseInt(t.value, 10, 64)
+			if err != nil {
+				return DMARC{}, fmt.Errorf("pct %q: must be a number", t.value)
+			}
+			d.Percent = &pct
+		case "rua":
+			d.RUA = splitURIs(t.value)
+		case "ruf":
+			d.RUF = splitURIs(t.value)
+		case "adkim":
+			d.ADKIM = t.value
+		case "aspf":
+			d.ASPF = t.value
+		case "fo":
+			d.FailureOptions = t.value
+		}
+	}
+	if !hasPolicy {
+		return DMARC{}, errors.New("record is missing the required p tag")
+	}
+
+	return d, d.Validate()
+}
+
+func isDMARCPolicy(policy string) bool {
+	return policy == "none" || policy == "quarantine" || policy == "reject"
+}
+
+// dmarcMailtoRegexp matches a mailto URI with an optional maximum report size (RFC 7489, section 6.2).
+var dmarcMailtoRegexp = regexp.MustCompile(`^mailto:([^!]+)(![0-9]+[kmgt]?)?$`)
+
+// validateDMARCURI checks that uri is a mailto URI with a plain email address.
+func validateDMARCURI(uri string) error {
+	match := dmarcMailtoRegexp.FindStringSubmatch(uri)
+	if match == nil {
+		return errors.New("must be an email address or mailto URI")
+	}
+	if address, err := mail.ParseAddress(match[1]); err != nil || address.Address != match[1] {
+		return fmt.Errorf("invalid email address %q", match[1])
+	}
+	return nil
+}
+
+// splitURIs splits a comma-separated list of URIs.
+func splitURIs(value string) []string {
+	var uris []string
+	for _, uri := range strings.Split(value, ",") {
+		if uri = strings.TrimSpace(uri); uri != "" {
+			uris = append(uris, uri)
+		}
+	}
+	return uris
+}
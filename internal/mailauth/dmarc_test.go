@@ -0,0 +1,124 @@
+package mailauth_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+)
+
+func TestDMARC_String(t *testing.T) {
+	pct := int64(50)
+	tests := []struct {
+		name  string
+		dmarc mailauth.DMARC
+		want  string
+	}{
+		{"minimal", mailauth.DMARC{Policy: "none"}, "v=DMARC1; p=none"},
+		{
+			"full",
+			mailauth.DMARC{
+				Policy:          "reject",
+				SubdomainPolicy: "quarantine",
+				Percent:         &pct,
+				RUA:             []string{"dmarc@example.com", "mailto:agg@example.net"},
+				RUF:             []string{"forensic@example.com"},
+				ADKIM:           "s",
+				ASPF:            "r",
+				FailureOptions:  "1",
+			},
+			"v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com,mailto:agg@example.net; ruf=mailto:forensic@example.com; adkim=s; aspf=r; fo=1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dmarc.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDMARC_Validate(t *testing.T) {
+	pct := int64(101)
+	tests := []struct {
+		name    string
+		dmarc   mailauth.DMARC
+		wantErr string
+	}{
+		{"valid", mailauth.DMARC{Policy: "reject", RUA: []string{"dmarc@example.com", "mailto:agg@example.net!10m"}, FailureOptions: "1:d"}, ""},
+		{"invalid policy", mailauth.DMARC{Policy: "block"}, `p "block": must be one of`},
+		{"invalid percent", mailauth.DMARC{Policy: "none", Percent: &pct}, "pct 101: must be between 0 and 100"},
+		{"invalid address", mailauth.DMARC{Policy: "none", RUA: []string{"not an address"}}, `invalid email address "not an address"`},
+		{"unsupported scheme", mailauth.DMARC{Policy: "none", RUF: []string{"https://example.com/dmarc"}}, "must be an email address or mailto URI"},
+		{"invalid alignment", mailauth.DMARC{Policy: "none", ADKIM: "x"}, `adkim "x": must be r or s`},
+		{"invalid failure options", mailauth.DMARC{Policy: "none", FailureOptions: "1:x"}, `fo "1:x"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.dmarc.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseDMARC(t *testing.T) {
+	pct := int64(25)
+	tests := []struct {
+		name    string
+		value   string
+		want    mailauth.DMARC
+		wantErr string
+	}{
+		{
+			name:  "full",
+			value: "v=DMARC1; p=quarantine; sp=reject; pct=25; rua=mailto:a@example.com, mailto:b@example.com; ruf=mailto:f@example.com; adkim=s; aspf=r; fo=1; ri=86400",
+			want: mailauth.DMARC{
+				Policy:          "quarantine",
+				SubdomainPolicy: "reject",
+				Percent:         &pct,
+				RUA:             []string{"mailto:a@example.com", "mailto:b@example.com"},
+				RUF:             []string{"mailto:f@example.com"},
+				ADKIM:           "s",
+				ASPF:            "r",
+				FailureOptions:  "1",
+			},
+		},
+		{
+			name:  "quoted",
+			value: `"v=DMARC1; " "p=none;"`,
+			want:  mailauth.DMARC{Policy: "none"},
+		},
+		{name: "missing version", value: "p=none", wantErr: "must start with v=DMARC1"},
+		{name: "missing policy", value: "v=DMARC1; rua=mailto:a@example.com", wantErr: "missing the required p tag"},
+		{name: "duplicate tag", value: "v=DMARC1; p=none; p=reject", wantErr: `duplicate tag "p"`},
+		{name: "invalid tag", value: "v=DMARC1; p", wantErr: `invalid tag "p"`},
+		{name: "invalid value", value: "v=DMARC1; p=none; pct=all", wantErr: `pct "all"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mailauth.ParseDMARC(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseDMARC() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDMARC() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDMARC() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
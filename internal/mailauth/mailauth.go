@@ -0,0 +1,137 @@
+// Package mailauth builds and parses the TXT record values used for email
+// authentication (SPF, DKIM, DMARC, MTA-STS and SMTP TLS reporting).
+package mailauth
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// MTASTS returns the value of the _mta-sts TXT record (RFC 8461) announcing the policy with the given ID.
+func MTASTS(policyID string) string {
+	return "v=STSv1; id=" + policyID
+}
+
+// TLSRPT returns the value of the _smtp._tls TXT record (RFC 8460) for the given report URIs.
+// Addresses without a URI scheme are treated as mailto URIs.
+func TLSRPT(rua []string) string {
+	return "v=TLSRPTv1; rua=" + joinURIs(rua)
+}
+
+// ValidateTLSRPT checks that every report URI of a TLS-RPT record is an email
+// address, a mailto URI or an https URL (RFC 8460, section 3). All problems
+// found are reported in the returned error.
+func ValidateTLSRPT(rua []string) error {
+	var errs []error
+	for _, address := range rua {
+		if err := validateTLSRPTURI(MailtoURI(address)); err != nil {
+			errs = append(errs, fmt.Errorf("rua %q: %w", address, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateTLSRPTURI(uri string) error {
+	if address, ok := strings.CutPrefix(uri, "mailto:"); ok {
+		if parsed, err := mail.ParseAddress(address); err != nil || parsed.Address != address {
+			return fmt.Errorf("invalid email address %q", address)
+		}
+		return nil
+	}
+	if u, err := url.Parse(uri); err != nil || u.Host == "" {
+		return errors.New("must be an email address, mailto URI or https URL")
+	}
+	return nil
+}
+
+// MailtoURI prefixes a plain email address with "mailto:". URIs that already
+// have a mailto or https scheme are returned unchanged.
+func MailtoURI(address string) string {
+	if strings.HasPrefix(address, "mailto:") || strings.HasPrefix(address, "https:") {
+		return address
+	}
+	return "mailto:" + address
+}
+
+// joinURIs converts addresses to URIs and joins them with commas.
+func joinURIs(addresses []string) string {
+	uris := make([]string, len(addresses))
+	for i, address := range addresses {
+		uris[i] = MailtoURI(address)
+	}
+	return strings.Join(uris, ",")
+}
+
+// tag is a single "name=value" pair of a tag list as used by DKIM and DMARC records.
+type tag struct {
+	name  string
+	value string
+}
+
+// parseTagList parses a semicolon-separated tag list (RFC 6376, section 3.2).
+// Whitespace around names and values is removed, and duplicate tags are rejected.
+func parseTagList(value string) ([]tag, error) {
+	var tags []tag
+	seen := map[string]bool{}
+	for _, spec := range strings.Split(unquoteTXT(value), ";") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(spec, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid tag %q", spec)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate tag %q", name)
+		}
+		seen[name] = true
+		tags = append(tags, tag{name: name, value: strings.TrimSpace(value)})
+	}
+	if len(tags) == 0 {
+		return nil, errors.New("record is empty")
+	}
+	return tags, nil
+}
+
+// unquoteTXT joins the character strings of a TXT record in presentation format,
+// such as `"v=DKIM1; " "p=MIIB..."`. Values that are not quoted are returned unchanged.
+func unquoteTXT(value string) string {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, `"`) {
+		return value
+	}
+
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(value); i++ {
+		switch c := value[i]; {
+		case c == '"':
+			quoted = !quoted
+		case c == '\\' && quoted && i+3 < len(value) && isDigits(value[i+1:i+4]):
+			n, _ := strconv.Atoi(value[i+1 : i+4])
+			b.WriteByte(byte(n))
+			i += 3
+		case c == '\\' && quoted && i+1 < len(value):
+			i++
+			b.WriteByte(value[i])
+		case quoted:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package mailauth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+)
+
+func TestMTASTS(t *testing.T) {
+	if got, want := mailauth.MTASTS("20240101"), "v=STSv1; id=20240101"; got != want {
+		t.Errorf("MTASTS() = %v, want %v", got, want)
+	}
+}
+
+func TestTLSRPT(t *testing.T) {
+	got := mailauth.TLSRPT([]string{"tls@example.com", "https://reports.example.com/tls"})
+	want := "v=TLSRPTv1; rua=mailto:tls@example.com,https://reports.example.com/tls"
+	if got != want {
+		t.Errorf("TLSRPT() = %v, want %v", got, want)
+	}
+}
+
+func TestValidateTLSRPT(t *testing.T) {
+	tests := []struct {
+		name    string
+		rua     []string
+		wantErr string
+	}{
+		{"valid", []string{"tls@example.com", "mailto:tls@example.net", "https://reports.example.com/tls"}, ""},
+		{"invalid address", []string{"not an address"}, `invalid email address "not an address"`},
+		{"https without host", []string{"https:///tls"}, "must be an email address, mailto URI or https URL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := mailauth.ValidateTLSRPT(tt.rua)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateTLSRPT() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ValidateTLSRPT() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
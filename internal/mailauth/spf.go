@@ -0,0 +1,287 @@
+package mailauth
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// MaxSPFLookups is the maximum number of terms causing DNS lookups that an SPF
+// record may contain before receivers fail its evaluation (RFC 7208, section 4.6.4).
+const MaxSPFLookups = 10
+
+// SPF describes a Sender Policy Framework record (RFC 7208).
+type SPF struct {
+	// A and MX add the "a" and "mx" mechanisms for the domain itself.
+	A  bool
+	MX bool
+	// IP4 and IP6 list addresses or CIDR ranges that are allowed to send mail.
+	IP4 []string
+	IP6 []string
+	// Includes lists domains whose SPF policy is included.
+	Includes []string
+	// Terms lists additional mechanisms and modifiers, such as "exists:%{i}._spf.example.com".
+	// They are added verbatim after the other mechanisms.
+	Terms []string
+	// All is the qualified "all" mechanism terminating the record, e.g. "~all".
+	// It is omitted if empty.
+	All string
+	// Redirect is the domain for the "redirect" modifier. It is omitted if empty.
+	Redirect string
+}
+
+// String returns the SPF record value.
+func (s SPF) String() string {
+	terms := []string{"v=spf1"}
+	if s.A {
+		terms = append(terms, "a")
+	}
+	if s.MX {
+		terms = append(terms, "mx")
+	}
+	for _, ip := range s.IP4 {
+		terms = append(terms, "ip4:"+ip)
+	}
+	for _, ip := range s.IP6 {
+		terms = append(terms, "ip6:"+ip)
+	}
+	for _, include := range s.Includes {
+		terms = append(terms, "include:"+include)
+	}
+	terms = append(terms, s.Terms...)
+	if s.Redirect != "" {
+		terms = append(terms, "redirect="+s.Redirect)
+	}
+	if s.All != "" {
+		terms = append(terms, s.All)
+	}
+	return strings.Join(terms, " ")
+}
+
+// Validate checks the syntax of every term and the structural rules of RFC 7208.
+// All problems found are reported in the returned error.
+func (s SPF) Validate() error {
+	var errs []error
+	for _, ip := range s.IP4 {
+		if err := validateIPNetwork(ip, false); err != nil {
+			errs = append(errs, fmt.Errorf("ip4 %q: %w", ip, err))
+		}
+	}
+	for _, ip := range s.IP6 {
+		if err := validateIPNetwork(ip, true); err != nil {
+			errs = append(errs, fmt.Errorf("ip6 %q: %w", ip, err))
+		}
+	}
+	for _, include := range s.Includes {
+		if err := validateDomainSpec(include); err != nil {
+			errs = append(errs, fmt.Errorf("include %q: %w", include, err))
+		}
+	}
+
+	var alls, redirects, exps int
+	if s.All != "" {
+		alls++
+		if term, err := parseSPFTerm(s.All); err != nil || term.name != "all" {
+			errs = append(errs, fmt.Errorf("all %q: must be one of +all, -all, ~all or ?all", s.All))
+		}
+	}
+	if s.Redirect != "" {
+		redirects++
+		if err := validateDomainSpec(s.Redirect); err != nil {
+			errs = append(errs, fmt.Errorf("redirect %q: %w", s.Redirect, err))
+		}
+	}
+	for _, raw := range s.Terms {
+		term, err := parseSPFTerm(raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("term %q: %w", raw, err))
+			continue
+		}
+		switch term.name {
+		case "all":
+			alls++
+		case "redirect":
+			redirects++
+		case "exp":
+			exps++
+		}
+	}
+
+	if alls > 1 {
+		errs = append(errs, fmt.Errorf("found %d all terms, but at most one is allowed", alls))
+	}
+	if redirects > 1 {
+		errs = append(errs, errors.New("the redirect modifier must not appear more than once"))
+	}
+	if exps > 1 {
+		errs = append(errs, errors.New("the exp modifier must not appear more than once"))
+	}
+	if redirects > 0 && alls > 0 {
+		errs = append(errs, errors.New("the redirect modifier has no effect when an all term is present"))
+	}
+	return errors.Join(errs...)
+}
+
+// Lookups returns the number of terms that cause DNS lookups when the record is
+// evaluated. Lookups caused by included records are not counted.
+func (s SPF) Lookups() int {
+	lookups := len(s.Includes)
+	if s.A {
+		lookups++
+	}
+	if s.MX {
+		lookups++
+	}
+	if s.Redirect != "" {
+		lookups++
+	}
+	for _, raw := range s.Terms {
+		if term, err := parseSPFTerm(raw); err == nil {
+			switch term.name {
+			case "a", "mx", "ptr", "include", "exists", "redirect":
+				lookups++
+			}
+		}
+	}
+	return lookups
+}
+
+// spfTerm is a parsed SPF mechanism or modifier.
+type spfTerm struct {
+	// name is the lower-cased mechanism or modifier name.
+	name string
+	// modifier is true for "name=value" terms.
+	modifier bool
+}
+
+var (
+	spfModifierNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9\-_.]*$`)
+	spfDualCIDRRegexp     = regexp.MustCompile(`^(/\d{1,2})?(//\d{1,3})?$`)
+)
+
+// parseSPFTerm parses and validates a single SPF mechanism or modifier.
+func parseSPFTerm(term string) (spfTerm, error) {
+	if term == "" || strings.ContainsAny(term, " \t") {
+		return spfTerm{}, errors.New("must be a single non-empty term")
+	}
+
+	if i := strings.IndexAny(term, ":/="); i >= 0 && term[i] == '=' {
+		name, value := strings.ToLower(term[:i]), term[i+1:]
+		if !spfModifierNameRegexp.MatchString(name) {
+			return spfTerm{}, fmt.Errorf("invalid modifier name %q", term[:i])
+		}
+		if name == "redirect" || name == "exp" {
+			if err := validateDomainSpec(value); err != nil {
+				return spfTerm{}, err
+			}
+		}
+		return spfTerm{name: name, modifier: true}, nil
+	}
+
+	term = strings.TrimLeft(term, "+-~?")
+	name, arg := term, ""
+	if i := strings.IndexAny(term, ":/"); i >= 0 {
+		name, arg = term[:i], term[i:]
+	}
+	name = strings.ToLower(name)
+
+	switch name {
+	case "all":
+		if arg != "" {
+			return spfTerm{}, errors.New("the all mechanism does not take an argument")
+		}
+	case "include", "exists":
+		domain, ok := strings.CutPrefix(arg, ":")
+		if !ok {
+			return spfTerm{}, fmt.Errorf("the %s mechanism requires a domain", name)
+		}
+		if err := validateDomainSpec(domain); err != nil {
+			return spfTerm{}, err
+		}
+	case "a", "mx", "ptr":
+		if rest, ok := strings.CutPrefix(arg, ":"); ok {
+			domain, _, _ := strings.Cut(rest, "/")
+			if err := validateDomainSpec(domain); err != nil {
+				return spfTerm{}, err
+			}
+			arg = strings.TrimPrefix(rest, domain)
+		}
+		if name == "ptr" && arg != "" {
+			return spfTerm{}, errors.New("the ptr mechanism does not take a CIDR length")
+		}
+		if !spfDualCIDRRegexp.MatchString(arg) {
+			return spfTerm{}, fmt.Errorf("invalid CIDR length %q", arg)
+		}
+	case "ip4", "ip6":
+		network, ok := strings.CutPrefix(arg, ":")
+		if !ok {
+			return spfTerm{}, fmt.Errorf("the %s mechanism requires an address", name)
+		}
+		if err := validateIPNetwork(network, name == "ip6"); err != nil {
+			return spfTerm{}, err
+		}
+	default:
+		return spfTerm{}, fmt.Errorf("unknown mechanism %q", name)
+	}
+	return spfTerm{name: name}, nil
+}
+
+// validateIPNetwork checks that value is an IPv4 or IPv6 address with an optional prefix length.
+func validateIPNetwork(value string, ipv6 bool) error {
+	address, bits, hasBits := strings.Cut(value, "/")
+	ip, err := netip.ParseAddr(address)
+	if err != nil {
+		return fmt.Errorf("invalid IP address %q", address)
+	}
+	if ipv6 && (!ip.Is6() || ip.Is4In6()) {
+		return errors.New("not an IPv6 address")
+	}
+	if !ipv6 && !ip.Is4() {
+		return errors.New("not an IPv4 address")
+	}
+	if hasBits {
+		if n, err := strconv.Atoi(bits); err != nil || n < 0 || n > ip.BitLen() {
+			return fmt.Errorf("invalid prefix length %q", bits)
+		}
+	}
+	return nil
+}
+
+// validateDomainSpec checks that spec is a domain name. Specs containing macros
+// are only checked for balanced macro expressions.
+func validateDomainSpec(spec string) error {
+	if spec == "" {
+		return errors.New("domain must not be empty")
+	}
+	if strings.Contains(spec, "%") {
+		for rest := spec; rest != ""; {
+			i := strings.Index(rest, "%")
+			if i < 0 {
+				break
+			}
+			rest = rest[i+1:]
+			switch {
+			case strings.HasPrefix(rest, "{"):
+				end := strings.Index(rest, "}")
+				if end < 0 {
+					return errors.New("unterminated macro expression")
+				}
+				rest = rest[end+1:]
+			case strings.HasPrefix(rest, "%"), strings.HasPrefix(rest, "_"), strings.HasPrefix(rest, "-"):
+				rest = rest[1:]
+			default:
+				return errors.New("invalid macro expression")
+			}
+		}
+		return nil
+	}
+	if _, ok := dns.IsDomainName(spec); !ok || !strings.Contains(strings.TrimSuffix(spec, "."), ".") {
+		return fmt.Errorf("invalid domain name %q", spec)
+	}
+	return nil
+}
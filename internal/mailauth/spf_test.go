@@ -0,0 +1,98 @@
+package mailauth_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+)
+
+func TestSPF_String(t *testing.T) {
+	tests := []struct {
+		name string
+		spf  mailauth.SPF
+		want string
+	}{
+		{"empty", mailauth.SPF{}, "v=spf1"},
+		{"include", mailauth.SPF{Includes: []string{"_spf.google.com"}, All: "~all"}, "v=spf1 include:_spf.google.com ~all"},
+		{
+			"all mechanisms",
+			mailauth.SPF{
+				A:        true,
+				MX:       true,
+				IP4:      []string{"192.0.2.0/24"},
+				IP6:      []string{"2001:db8::/32"},
+				Includes: []string{"spf.example.net"},
+				All:      "-all",
+			},
+			"v=spf1 a mx ip4:192.0.2.0/24 ip6:2001:db8::/32 include:spf.example.net -all",
+		},
+		{"redirect", mailauth.SPF{Redirect: "_spf.example.com"}, "v=spf1 redirect=_spf.example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spf.String(); got != tt.want {
+				t.Errorf("String() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSPF_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spf     mailauth.SPF
+		wantErr string
+	}{
+		{"empty", mailauth.SPF{}, ""},
+		{
+			"valid",
+			mailauth.SPF{
+				IP4:      []string{"192.0.2.1", "198.51.100.0/24"},
+				IP6:      []string{"2001:db8::/32"},
+				Includes: []string{"_spf.google.com"},
+				Terms:    []string{"a:mail.example.com/24", "mx//64", "exists:%{i}._spf.example.com", "exp=explain.example.com"},
+				All:      "-all",
+			},
+			"",
+		},
+		{"invalid ip4", mailauth.SPF{IP4: []string{"2001:db8::1"}}, `ip4 "2001:db8::1": not an IPv4 address`},
+		{"invalid ip6", mailauth.SPF{IP6: []string{"192.0.2.1"}}, `ip6 "192.0.2.1": not an IPv6 address`},
+		{"invalid prefix", mailauth.SPF{IP4: []string{"192.0.2.0/33"}}, `invalid prefix length "33"`},
+		{"invalid include", mailauth.SPF{Includes: []string{"localhost"}}, `include "localhost": invalid domain name`},
+		{"invalid all", mailauth.SPF{All: "all-"}, `all "all-": must be one of`},
+		{"unknown mechanism", mailauth.SPF{Terms: []string{"foo:example.com"}}, `unknown mechanism "foo"`},
+		{"invalid macro", mailauth.SPF{Terms: []string{"exists:%{i._spf.example.com"}}, "unterminated macro expression"},
+		{"multiple all", mailauth.SPF{Terms: []string{"?all"}, All: "-all"}, "found 2 all terms"},
+		{"redirect with all", mailauth.SPF{Redirect: "_spf.example.com", All: "-all"}, "redirect modifier has no effect"},
+		{"multiple redirects", mailauth.SPF{Redirect: "_spf.example.com", Terms: []string{"redirect=example.net"}}, "must not appear more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.spf.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSPF_Lookups(t *testing.T) {
+	spf := mailauth.SPF{
+		A:        true,
+		MX:       true,
+		IP4:      []string{"192.0.2.1"},
+		Includes: []string{"_spf.google.com", "spf.protection.outlook.com"},
+		Terms:    []string{"ptr", "exists:%{i}.example.com", "ip6:2001:db8::1", "exp=explain.example.com"},
+		Redirect: "_spf.example.com",
+	}
+	if got, want := spf.Lookups(), 7; got != want {
+		t.Errorf("Lookups() = %d, want %d", got, want)
+	}
+}
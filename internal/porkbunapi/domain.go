@@ -0,0 +1,123 @@
+package porkbunapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// UpdateAutoRenew enables or disables the automatic renewal of the domain.
+func (c *Client) UpdateAutoRenew(ctx context.Context, domain string, enabled bool) error {
+	status := "off"
+	if enabled {
+		status = "on"
+	}
+
+	// The endpoint accepts several domains and reports the result per domain.
+	var resp struct {
+		Results map[string]struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"results"`
+	}
+	if err := c.post(ctx, "/domain/updateAutoRenew/"+domain, map[string]any{"status": status}, &resp); err != nil {
+		return err
+	}
+	if result, ok := resp.Results[domain]; ok && result.Status != "SUCCESS" {
+		return &Error{StatusCode: http.StatusOK, Message: result.Message}
+	}
+	return nil
+}
+
+// DomainAvailability is the result of checking whether a domain can be registered.
+type DomainAvailability struct {
+	Available bool
+	Premium   bool
+	// Price is the price of registering the domain for the first year, in US cents.
+	Price int64
+	// RenewalPrice is the price of each additional year, in US cents.
+	RenewalPrice int64
+}
+
+// CheckDomain checks whether the domain is available for registration, and at which price.
+func (c *Client) CheckDomain(ctx context.Context, domain string) (*DomainAvailability, error) {
+	var resp struct {
+		Response struct {
+			Avail      string `json:"avail"`
+			Price      string `json:"price"`
+			Premium    string `json:"premium"`
+			Additional struct {
+				Renewal struct {
+					Price string `json:"price"`
+				} `json:"renewal"`
+			} `json:"additional"`
+		} `json:"response"`
+	}
+	if err := c.post(ctx, "/domain/checkDomain/"+domain, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	availability := &DomainAvailability{
+		Available: resp.Response.Avail == "yes",
+		Premium:   resp.Response.Premium == "yes",
+	}
+	if !availability.Available {
+		return availability, nil
+	}
+
+	var err error
+	if availability.Price, err = ParsePrice(resp.Response.Price); err != nil {
+		return nil, fmt.Errorf("invalid registration price of %s: %w", domain, err)
+	}
+	availability.RenewalPrice = availability.Price
+	if renewal := resp.Response.Additional.Renewal.Price; renewal != "" {
+		if availability.RenewalPrice, err = ParsePrice(renewal); err != nil {
+			return nil, fmt.Errorf("invalid renewal price of %s: %w", domain, err)
+		}
+	}
+	return availability, nil
+}
+
+// Cost returns the price of registering the domain for the given number of years, in US cents.
+func (a *DomainAvailability) Cost(years int64) int64 {
+	return a.Price + (years-1)*a.RenewalPrice
+}
+
+// CreateDomain registers the domain for the given number of years. The cost,
+// in US cents, must match the price quoted by CheckDomain; the registration
+// fails otherwise, so that the account is never charged more than expected.
+func (c *Client) CreateDomain(ctx context.Context, domain string, years, cost int64) error {
+	return c.post(ctx, "/domain/create/"+domain, map[string]any{
+		"years":        years,
+		"cost":         cost,
+		"agreeToTerms": "yes",
+	}, nil)
+}
+
+// ParsePrice parses a price in US dollars, such as "9.68", into US cents.
+func ParsePrice(s string) (int64, error) {
+	dollars, cents, hasCents := strings.Cut(strings.TrimSpace(s), ".")
+	if hasCents {
+		cents = strings.TrimRight(cents, "0")
+	}
+	if dollars == "" || len(cents) > 2 {
+		return 0, fmt.Errorf("invalid price %q", s)
+	}
+
+	d, err := strconv.ParseUint(dollars, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("invalid price %q", s)
+	}
+	var c uint64
+	if cents != "" {
+		if c, err = strconv.ParseUint(cents, 10, 8); err != nil {
+			return 0, fmt.Errorf("invalid price %q", s)
+		}
+		if len(cents) == 1 {
+			c *= 10
+		}
+	}
+	return int64(d*100 + c), nil
+}
@@ -0,0 +1,69 @@
+package porkbunapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GlueRecord is a glue record of a domain, publishing the addresses of a
+// nameserver within the domain at the registry.
+type GlueRecord struct {
+	Host string   // Fully-qualified host name, e.g. "ns1.example.com".
+	IPv4 []string // IPv4 addresses of the host.
+	IPv6 []string // IPv6 addresses of the host.
+}
+
+// UnmarshalJSON decodes a glue record from its API form, a tuple of the host
+// name and an object of its addresses.
+func (g *GlueRecord) UnmarshalJSON(data []byte) error {
+	var tuple []json.RawMessage
+	if err := json.Unmarshal(data, &tuple); err != nil {
+		return err
+	}
+	if len(tuple) != 2 {
+		return fmt.Errorf("expected glue record of host and addresses, got %s", data)
+	}
+
+	var addresses struct {
+		V4 []string `json:"v4"`
+		V6 []string `json:"v6"`
+	}
+	if err := json.Unmarshal(tuple[0], &g.Host); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tuple[1], &addresses); err != nil {
+		return err
+	}
+	g.IPv4, g.IPv6 = addresses.V4, addresses.V6
+	return nil
+}
+
+// GetGlueRecords retrieves all glue records of the domain.
+func (c *Client) GetGlueRecords(ctx context.Context, domain string) ([]GlueRecord, error) {
+	var resp struct {
+		Hosts []GlueRecord `json:"hosts"`
+	}
+	if err := c.post(ctx, "/domain/getGlue/"+domain, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Hosts, nil
+}
+
+// CreateGlueRecord creates a glue record for the host, given as the subdomain
+// of the domain (e.g. "ns1"), with the IPv4 and IPv6 addresses.
+func (c *Client) CreateGlueRecord(ctx context.Context, domain, subdomain string, ips []string) error {
+	return c.post(ctx, "/domain/createGlue/"+domain+"/"+subdomain, map[string]any{"ips": ips}, nil)
+}
+
+// UpdateGlueRecord replaces the addresses of the glue record for the host,
+// given as the subdomain of the domain.
+func (c *Client) UpdateGlueRecord(ctx context.Context, domain, subdomain string, ips []string) error {
+	return c.post(ctx, "/domain/updateGlue/"+domain+"/"+subdomain, map[string]any{"ips": ips}, nil)
+}
+
+// DeleteGlueRecord deletes the glue record for the host, given as the
+// subdomain of the domain.
+func (c *Client) DeleteGlueRecord(ctx context.Context, domain, subdomain string) error {
+	return c.post(ctx, "/domain/deleteGlue/"+domain+"/"+subdomain, nil, nil)
+}
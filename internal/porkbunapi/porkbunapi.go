@@ -0,0 +1,113 @@
+// Package porkbunapi implements the Porkbun API endpoints that are not covered
+// by the porkbun-go client, such as glue records and domain registration.
+package porkbunapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+const (
+	defaultBaseURL  = "https://api.porkbun.com/api/json/v3"
+	ipv4OnlyBaseURL = "https://api-ipv4.porkbun.com/api/json/v3"
+)
+
+// Options defines the configuration of the client.
+type Options struct {
+	HTTPClient   porkbun.HTTPClient // Defaults to http.DefaultClient if nil.
+	APIKey       string
+	SecretAPIKey string
+	IPv4Only     bool
+}
+
+// Client calls Porkbun API endpoints using the same credentials as porkbun-go.
+type Client struct {
+	httpClient   porkbun.HTTPClient
+	baseURL      string
+	apiKey       string
+	secretAPIKey string
+}
+
+// NewClient creates a new client with the given options.
+func NewClient(options *Options) *Client {
+	client := &Client{
+		httpClient:   options.HTTPClient,
+		baseURL:      defaultBaseURL,
+		apiKey:       options.APIKey,
+		secretAPIKey: options.SecretAPIKey,
+	}
+	if client.httpClient == nil {
+		client.httpClient = http.DefaultClient
+	}
+	if options.IPv4Only {
+		client.baseURL = ipv4OnlyBaseURL
+	}
+	return client
+}
+
+// Error is returned for requests the API did not process successfully.
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("HTTP error %d: %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	return fmt.Sprintf("%d: %s", e.StatusCode, e.Message)
+}
+
+// post sends the payload with the credentials to the endpoint at path and
+// decodes the response into out, if not nil.
+func (c *Client) post(ctx context.Context, path string, payload map[string]any, out any) error {
+	body := map[string]any{"apikey": c.apiKey, "secretapikey": c.secretAPIKey}
+	for key, value := range payload {
+		body[key] = value
+	}
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(encoded))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	var status struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(raw, &status)
+	if resp.StatusCode != http.StatusOK || status.Status != "SUCCESS" {
+		return &Error{StatusCode: resp.StatusCode, Message: status.Message}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(raw, out); err != nil {
+		return fmt.Errorf("failed decoding response of %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package porkbunapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// newTestClient returns a client sending requests to handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient(&Options{APIKey: "pk1_test", SecretAPIKey: "sk1_test"})
+	client.baseURL = server.URL + "/api/json/v3"
+	return client
+}
+
+func TestClient_post(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		if req.URL.Path != "/api/json/v3/domain/getGlue/example.com" || body["apikey"] != "pk1_test" || body["secretapikey"] != "sk1_test" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"ERROR","message":"unexpected request"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"SUCCESS"}`))
+	})
+
+	if err := client.post(context.Background(), "/domain/getGlue/example.com", nil, nil); err != nil {
+		t.Fatalf("post() unexpected error: %v", err)
+	}
+
+	var apiErr *Error
+	err := client.post(context.Background(), "/domain/getGlue/example.org", nil, nil)
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest || apiErr.Message != "unexpected request" {
+		t.Errorf("post() error = %v, want API error", err)
+	}
+}
+
+func TestClient_GetGlueRecords(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{"status":"SUCCESS","hosts":[["ns1.example.com",{"v6":["2001:db8::1"],"v4":["192.0.2.1","192.0.2.2"]}],["ns2.example.com",{"v4":["192.0.2.3"]}]]}`))
+	})
+
+	got, err := client.GetGlueRecords(context.Background(), "example.com")
+	if err != nil {
+		t.Fatalf("GetGlueRecords() unexpected error: %v", err)
+	}
+	want := []GlueRecord{
+		{Host: "ns1.example.com", IPv4: []string{"192.0.2.1", "192.0.2.2"}, IPv6: []string{"2001:db8::1"}},
+		{Host: "ns2.example.com", IPv4: []string{"192.0.2.3"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetGlueRecords() = %+v, want %+v", got, want)
+	}
+}
+
+func TestClient_GlueRecordWrites(t *testing.T) {
+	var paths []string
+	var ips []any
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		paths = append(paths, req.URL.Path)
+		ips, _ = body["ips"].([]any)
+		_, _ = w.Write([]byte(`{"status":"SUCCESS"}`))
+	})
+	ctx := context.Background()
+
+	if err := client.CreateGlueRecord(ctx, "example.com", "ns1", []string{"192.0.2.1", "2001:db8::1"}); err != nil {
+		t.Fatalf("CreateGlueRecord() unexpected error: %v", err)
+	}
+	if len(ips) != 2 {
+		t.Errorf("CreateGlueRecord() sent ips %v, want both addresses", ips)
+	}
+	if err := client.UpdateGlueRecord(ctx, "example.com", "ns1", []string{"192.0.2.2"}); err != nil {
+		t.Fatalf("UpdateGlueRecord() unexpected error: %v", err)
+	}
+	if err := client.DeleteGlueRecord(ctx, "example.com", "ns1"); err != nil {
+		t.Fatalf("DeleteGlueRecord() unexpected error: %v", err)
+	}
+
+	want := []string{
+		"/api/json/v3/domain/createGlue/example.com/ns1",
+		"/api/json/v3/domain/updateGlue/example.com/ns1",
+		"/api/json/v3/domain/deleteGlue/example.com/ns1",
+	}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("request paths = %v, want %v", paths, want)
+	}
+}
+
+func TestClient_UpdateAutoRenew(t *testing.T) {
+	var path string
+	var status any
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		path, status = req.URL.Path, body["status"]
+		if req.URL.Path == "/api/json/v3/domain/updateAutoRenew/example.org" {
+			_, _ = w.Write([]byte(`{"status":"SUCCESS","results":{"example.org":{"status":"ERROR","message":"Domain is not eligible."}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"SUCCESS","results":{"example.com":{"status":"SUCCESS","message":"Auto renew status updated."}}}`))
+	})
+	ctx := context.Background()
+
+	if err := client.UpdateAutoRenew(ctx, "example.com", true); err != nil {
+		t.Fatalf("UpdateAutoRenew() unexpected error: %v", err)
+	}
+	if path != "/api/json/v3/domain/updateAutoRenew/example.com" || status != "on" {
+		t.Errorf("UpdateAutoRenew() sent status %v to %s, want on", status, path)
+	}
+	if err := client.UpdateAutoRenew(ctx, "example.com", false); err != nil || status != "off" {
+		t.Errorf("UpdateAutoRenew() error = %v, sent status %v, want off", err, status)
+	}
+
+	var apiErr *Error
+	if err := client.UpdateAutoRenew(ctx, "example.org", true); !errors.As(err, &apiErr) || apiErr.Message != "Domain is not eligible." {
+		t.Errorf("UpdateAutoRenew() error = %v, want the result of the domain", err)
+	}
+}
+
+func TestParsePrice(t *testing.T) {
+	tests := []struct {
+		price   string
+		want    int64
+		wantErr bool
+	}{
+		{price: "9.68", want: 968},
+		{price: "12", want: 1200},
+		{price: "12.5", want: 1250},
+		{price: "1,200.00", wantErr: true},
+		{price: "3200.00", want: 320000},
+		{price: "0.999", wantErr: true},
+		{price: "", wantErr: true},
+		{price: "-1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.price, func(t *testing.T) {
+			got, err := ParsePrice(tt.price)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePrice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParsePrice() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_CheckDomain(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/api/json/v3/domain/checkDomain/example.com":
+			_, _ = w.Write([]byte(`{"status":"SUCCESS","response":{"avail":"yes","type":"registration","price":"9.68","firstYearPromo":"no","regularPrice":"9.68","premium":"no","additional":{"renewal":{"type":"renewal","price":"10.37","regularPrice":"10.37"}}}}`))
+		case "/api/json/v3/domain/checkDomain/premium.com":
+			_, _ = w.Write([]byte(`{"status":"SUCCESS","response":{"avail":"yes","type":"registration","price":"3200.00","premium":"yes"}}`))
+		default:
+			_, _ = w.Write([]byte(`{"status":"SUCCESS","response":{"avail":"no","price":"9.68","premium":"no"}}`))
+		}
+	})
+	ctx := context.Background()
+
+	got, err := client.CheckDomain(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("CheckDomain() unexpected error: %v", err)
+	}
+	if want := (DomainAvailability{Available: true, Price: 968, RenewalPrice: 1037}); *got != want {
+		t.Errorf("CheckDomain() = %+v, want %+v", *got, want)
+	}
+	if cost := got.Cost(3); cost != 968+2*1037 {
+		t.Errorf("Cost(3) = %d, want %d", cost, 968+2*1037)
+	}
+
+	got, err = client.CheckDomain(ctx, "premium.com")
+	if err != nil {
+		t.Fatalf("CheckDomain() unexpected error: %v", err)
+	}
+	if want := (DomainAvailability{Available: true, Premium: true, Price: 320000, RenewalPrice: 320000}); *got != want {
+		t.Errorf("CheckDomain() = %+v, want %+v", *got, want)
+	}
+
+	got, err = client.CheckDomain(ctx, "taken.com")
+	if err != nil || got.Available {
+		t.Errorf("CheckDomain() = %+v, %v, want unavailable", got, err)
+	}
+}
+
+func TestClient_CreateDomain(t *testing.T) {
+	var path string
+	var body map[string]any
+	client := newTestClient(t, func(w http.ResponseWriter, req *http.Request) {
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		path = req.URL.Path
+		_, _ = w.Write([]byte(`{"status":"SUCCESS","domain":"example.com","cost":1936,"orderId":123456,"balance":1000}`))
+	})
+
+	if err := client.CreateDomain(context.Background(), "example.com", 2, 1936); err != nil {
+		t.Fatalf("CreateDomain() unexpected error: %v", err)
+	}
+	if path != "/api/json/v3/domain/create/example.com" || body["cost"] != float64(1936) || body["years"] != float64(2) || body["agreeToTerms"] != "yes" {
+		t.Errorf("CreateDomain() sent %v to %s", body, path)
+	}
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/caa"
+)
+
+var _ function.Function = &CAAFunction{}
+
+func NewCAAFunction() function.Function {
+	return &CAAFunction{}
+}
+
+// CAAFunction builds the content of the CAA records for a policy.
+type CAAFunction struct{}
+
+func (f *CAAFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "caa"
+}
+
+func (f *CAAFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build CAA record values",
+		MarkdownDescription: "Builds the content of the CAA records (RFC 8659) for a policy, such as `0 issue \"letsencrypt.org\"`, " +
+			"returning one value per `porkbun_dns_record` of type `CAA`. " +
+			"Issuer domains, RFC 8657 parameters and `iodef` URIs are validated, and all values are quoted consistently.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "policy",
+				MarkdownDescription: "An object with any of the following attributes: " +
+					"`issue` and `issuewild` (list) list the CAs allowed to issue certificates and wildcard certificates. " +
+					"Each entry is either an issuer domain such as `letsencrypt.org`, or an object with the attributes " +
+					"`domain` (string), `accounturi` (string) and `validationmethods` (list of string, such as `dns-01`). " +
+					"An empty issuer domain forbids issuance by any CA; " +
+					"`iodef` (list of string) lists email addresses or `mailto:`, `http:` or `https:` URIs for violation reports; " +
+					"`critical` (list of string) lists the tags whose records have the critical flag set.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *CAAFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &config))
+	if resp.Error != nil {
+		return
+	}
+
+	policy, funcErr := caaFromArgument(config)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if err := policy.Validate(); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid CAA policy: %s", err))
+		return
+	}
+
+	records := make([]attr.Value, 0, len(policy.Records()))
+	for _, record := range policy.Records() {
+		records = append(records, types.StringValue(record))
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.ListValueMust(types.StringType, records)))
+}
+
+// caaFromArgument converts the policy argument to a CAA policy.
+func caaFromArgument(config types.Dynamic) (caa.Policy, *function.FuncError) {
+	args, funcErr := newObjectArgument(config, 0, "issue", "issuewild", "iodef", "critical")
+	if funcErr != nil {
+		return caa.Policy{}, funcErr
+	}
+
+	var policy caa.Policy
+	var errs [4]*function.FuncError
+	policy.Issue, errs[0] = caaIssuersFromArgument(args, "issue")
+	policy.IssueWild, errs[1] = caaIssuersFromArgument(args, "issuewild")
+	policy.IODEF, errs[2] = args.Strings("iodef")
+	policy.Critical, errs[3] = args.Strings("critical")
+
+	return policy, function.ConcatFuncErrors(errs[:]...)
+}
+
+// caaIssuersFromArgument converts a list of issuer domains or issuer objects.
+func caaIssuersFromArgument(args *objectArgument, name string) ([]caa.Issuer, *function.FuncError) {
+	elements, funcErr := args.Elements(name, "a list of issuers")
+	if funcErr != nil {
+		return nil, funcErr
+	}
+
+	issuers := make([]caa.Issuer, 0, len(elements))
+	for i, element := range elements {
+		if domain, ok := element.(types.String); ok && !domain.IsNull() {
+			issuers = append(issuers, caa.Issuer{Domain: domain.ValueString()})
+			continue
+		}
+
+		issuerArgs, funcErr := args.Object(name, i, element, "domain", "accounturi", "validationmethods")
+		if funcErr != nil {
+			return nil, funcErr
+		}
+		var issuer caa.Issuer
+		var errs [3]*function.FuncError
+		issuer.Domain, errs[0] = issuerArgs.String("domain")
+		issuer.AccountURI, errs[1] = issuerArgs.String("accounturi")
+		issuer.ValidationMethods, errs[2] = issuerArgs.Strings("validationmethods")
+		if funcErr := function.ConcatFuncErrors(errs[:]...); funcErr != nil {
+			return nil, funcErr
+		}
+		issuers = append(issuers, issuer)
+	}
+	return issuers, nil
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCAAFunction(t *testing.T) {
+	issuer := dynamicObject(map[string]attr.Value{
+		"domain":            types.StringValue("letsencrypt.org"),
+		"accounturi":        types.StringValue("https://acme-v02.api.letsencrypt.org/acme/acct/1234"),
+		"validationmethods": stringTuple("dns-01"),
+	}).UnderlyingValue()
+	issue := types.TupleValueMust(
+		[]attr.Type{issuer.Type(t.Context()), types.StringType},
+		[]attr.Value{issuer, types.StringValue("sectigo.com")},
+	)
+
+	tests := []struct {
+		name    string
+		config  map[string]attr.Value
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "full",
+			config: map[string]attr.Value{
+				"issue":     issue,
+				"issuewild": stringTuple(""),
+				"iodef":     stringTuple("security@example.com"),
+				"critical":  stringTuple("issue"),
+			},
+			want: []string{
+				`128 issue "letsencrypt.org; accounturi=https://acme-v02.api.letsencrypt.org/acme/acct/1234; validationmethods=dns-01"`,
+				`128 issue "sectigo.com"`,
+				`0 issuewild ";"`,
+				`0 iodef "mailto:security@example.com"`,
+			},
+		},
+		{
+			name:    "invalid issuer",
+			config:  map[string]attr.Value{"issue": stringTuple(`"letsencrypt.org"`)},
+			wantErr: "must be a lower-case domain name",
+		},
+		{
+			name:    "invalid issuer type",
+			config:  map[string]attr.Value{"issue": types.TupleValueMust([]attr.Type{types.BoolType}, []attr.Value{types.BoolValue(true)})},
+			wantErr: `Invalid "issue[0]": expected an object.`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, funcErr := runFunction(t, NewCAAFunction(), dynamicObject(tt.config))
+			if tt.wantErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tt.wantErr) {
+					t.Fatalf("Run() error = %v, want error containing %q", funcErr, tt.wantErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("Run() unexpected error: %v", funcErr)
+			}
+
+			elems := make([]attr.Value, len(tt.want))
+			for i, v := range tt.want {
+				elems[i] = types.StringValue(v)
+			}
+			if want := types.ListValueMust(types.StringType, elems); !got.Equal(want) {
+				t.Errorf("Run() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/delegation"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+var _ datasource.DataSource = &DelegationCheckDataSource{}
+
+// delegationCheckTimeoutDefault is the default timeout of a single DNS query, in seconds.
+const delegationCheckTimeoutDefault = 5
+
+// delegationResultObjectAttrs defines the attributes for the result object.
+var delegationResultObjectAttrs = map[string]attr.Type{
+	"nameserver":    types.StringType,
+	"address":       types.StringType,
+	"reachable":     types.BoolType,
+	"authoritative": types.BoolType,
+	"serial":        types.Int64Type,
+	"ns":            types.ListType{ElemType: types.StringType},
+	"error":         types.StringType,
+}
+
+func NewDelegationCheckDataSource() datasource.DataSource {
+	return &DelegationCheckDataSource{}
+}
+
+// DelegationCheckDataSource defines the data source implementation.
+type DelegationCheckDataSource struct {
+	client *porkbun.Client
+}
+
+// DelegationCheckDataSourceModel describes the data source data model.
+type DelegationCheckDataSourceModel struct {
+	Domain      types.String `tfsdk:"domain"`
+	Nameservers types.List   `tfsdk:"nameservers"`
+	Resolvers   types.List   `tfsdk:"resolvers"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+
+	Results                types.List `tfsdk:"results"`
+	LameDelegations        types.List `tfsdk:"lame_delegations"`
+	UnreachableNameservers types.List `tfsdk:"unreachable_nameservers"`
+	SerialMismatch         types.Bool `tfsdk:"serial_mismatch"`
+	Healthy                types.Bool `tfsdk:"healthy"`
+}
+
+func (d *DelegationCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delegation_check"
+}
+
+func (d *DelegationCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Queries each nameserver of a domain for the SOA and NS records of the zone to verify that the delegation works. " +
+			"Problems are reported as attributes instead of errors, so they can be asserted on in `check` blocks.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to check the delegation of (e.g., example.com).",
+				Required:            true,
+			},
+			"nameservers": schema.ListAttribute{
+				MarkdownDescription: "The nameservers to query, as host names or IP addresses with an optional port, e.g. `ns1.example.com` or `192.0.2.1:5353`. " +
+					"Defaults to the nameservers of the domain at Porkbun.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"resolvers": schema.ListAttribute{
+				MarkdownDescription: "Addresses of the DNS servers used to look up the addresses of nameservers given by host name, as `host` or `host:port`. Defaults to the system resolver.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Timeout of a single DNS query, in seconds. Defaults to %d.", delegationCheckTimeoutDefault),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"results": schema.ListAttribute{
+				MarkdownDescription: "The result of querying each address of each nameserver. `serial` is only set for authoritative answers, and `error` describes the problem, if any.",
+				Computed:            true,
+				ElementType: types.ObjectType{
+					AttrTypes: delegationResultObjectAttrs,
+				},
+			},
+			"lame_delegations": schema.ListAttribute{
+				MarkdownDescription: "The nameservers that answered without serving the zone authoritatively.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"unreachable_nameservers": schema.ListAttribute{
+				MarkdownDescription: "The nameservers that could not be resolved or did not answer on any of their addresses.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"serial_mismatch": schema.BoolAttribute{
+				MarkdownDescription: "Whether the nameservers answered with different SOA serials.",
+				Computed:            true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether all nameservers served the zone authoritatively with the same SOA serial.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DelegationCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (d *DelegationCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DelegationCheckDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := data.Domain.ValueString()
+	nameservers, _ := util.StringsFromList(data.Nameservers)
+	if len(nameservers) == 0 {
+		nsResp, err := d.client.Domains.GetNameServers(ctx, domain)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading Nameservers", err.Error())
+			return
+		}
+		nameservers = nsResp.NS
+	}
+
+	resolvers, _ := util.StringsFromList(data.Resolvers)
+	timeout := int64(delegationCheckTimeoutDefault)
+	if !data.Timeout.IsNull() {
+		timeout = data.Timeout.ValueInt64()
+	}
+
+	checker := &delegation.Checker{
+		Client:    resolver.NewClient(time.Duration(timeout) * time.Second),
+		Resolvers: resolvers,
+	}
+	report := checker.Check(ctx, domain, nameservers)
+
+	data.Results = convertDelegationResultsToList(report.Results)
+	data.LameDelegations = stringsToListOrEmpty(report.Lame)
+	data.UnreachableNameservers = stringsToListOrEmpty(report.Unreachable)
+	data.SerialMismatch = types.BoolValue(report.SerialMismatch)
+	data.Healthy = types.BoolValue(report.Healthy())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertDelegationResultsToList converts a slice of delegation.Result to a types.List.
+func convertDelegationResultsToList(results []delegation.Result) types.List {
+	return util.MustMapToList(results, types.ObjectType{AttrTypes: delegationResultObjectAttrs}, func(result delegation.Result) attr.Value {
+		serial := types.Int64Null()
+		if result.Authoritative {
+			serial = types.Int64Value(int64(result.Serial))
+		}
+		return types.ObjectValueMust(
+			delegationResultObjectAttrs,
+			map[string]attr.Value{
+				"nameserver":    types.StringValue(result.Nameserver),
+				"address":       util.StringOrNull(result.Address),
+				"reachable":     types.BoolValue(result.Reachable),
+				"authoritative": types.BoolValue(result.Authoritative),
+				"serial":        serial,
+				"ns":            stringsToListOrEmpty(result.NS),
+				"error":         util.StringOrNull(result.Error),
+			},
+		)
+	})
+}
+
+// stringsToListOrEmpty converts a slice of strings to a types.List. Unlike
+// util.StringsToList, an empty slice results in an empty list, so that
+// length checks work in check blocks.
+func stringsToListOrEmpty(values []string) types.List {
+	return util.MustMapToList(values, types.StringType, func(v string) attr.Value { return types.StringValue(v) })
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver/resolvertest"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+func TestAccDelegationCheckDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDelegationCheckDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.porkbun_delegation_check.test",
+						tfjsonpath.New("results"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDelegationCheckDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "porkbun_delegation_check" "test" {
+  domain = %q
+}
+`, testAccDomain())
+}
+
+func TestDelegationCheckDataSource_Read(t *testing.T) {
+	zone := func(serial string) []string {
+		return []string{
+			"example.com. 3600 IN SOA ns1.example.com. hostmaster.example.com. " + serial + " 7200 3600 1209600 3600",
+			"example.com. 3600 IN NS ns1.example.com.",
+		}
+	}
+	current := resolvertest.NewServer(t, zone("2024010101")...)
+	stale := resolvertest.NewServer(t, zone("2023120101")...)
+	lame := resolvertest.NewServer(t)
+
+	api := newFakeAPI()
+	api.ns["example.com"] = []string{current.Addr, stale.Addr}
+	d := &DelegationCheckDataSource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name        string
+		nameservers types.List
+		wantLame    []string
+		wantHealthy bool
+		wantSerial  bool
+	}{
+		{
+			name:        "nameservers at Porkbun",
+			nameservers: types.ListNull(types.StringType),
+			wantSerial:  true,
+		},
+		{
+			name:        "configured nameservers",
+			nameservers: util.StringsToList([]string{current.Addr}),
+			wantHealthy: true,
+		},
+		{
+			name:        "lame delegation",
+			nameservers: util.StringsToList([]string{current.Addr, lame.Addr}),
+			wantLame:    []string{lame.Addr},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			config.Set(ctx, &DelegationCheckDataSourceModel{
+				Domain:                 types.StringValue("example.com"),
+				Nameservers:            tt.nameservers,
+				Resolvers:              types.ListNull(types.StringType),
+				Timeout:                types.Int64Value(1),
+				Results:                types.ListNull(types.ObjectType{AttrTypes: delegationResultObjectAttrs}),
+				LameDelegations:        types.ListNull(types.StringType),
+				UnreachableNameservers: types.ListNull(types.StringType),
+				SerialMismatch:         types.BoolNull(),
+				Healthy:                types.BoolNull(),
+			})
+
+			req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got DelegationCheckDataSourceModel
+			resp.State.Get(ctx, &got)
+			if want := stringsToListOrEmpty(tt.wantLame); !got.LameDelegations.Equal(want) {
+				t.Errorf("lame_delegations = %v, want %v", got.LameDelegations, want)
+			}
+			if !got.UnreachableNameservers.Equal(types.ListValueMust(types.StringType, []attr.Value{})) {
+				t.Errorf("unreachable_nameservers = %v, want empty", got.UnreachableNameservers)
+			}
+			if got.SerialMismatch.ValueBool() != tt.wantSerial {
+				t.Errorf("serial_mismatch = %v, want %t", got.SerialMismatch, tt.wantSerial)
+			}
+			if got.Healthy.ValueBool() != tt.wantHealthy {
+				t.Errorf("healthy = %v, want %t", got.Healthy, tt.wantHealthy)
+			}
+		})
+	}
+}
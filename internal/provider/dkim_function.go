@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+)
+
+var _ function.Function = &DKIMFunction{}
+
+func NewDKIMFunction() function.Function {
+	return &DKIMFunction{}
+}
+
+// DKIMFunction builds the value of a DKIM key TXT record from a PEM-encoded public key.
+type DKIMFunction struct{}
+
+func (f *DKIMFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dkim"
+}
+
+func (f *DKIMFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a DKIM record value",
+		MarkdownDescription: "Builds the value of a DKIM key TXT record (RFC 6376) to publish at `<selector>._domainkey`, such as `v=DKIM1; k=rsa; p=MIIB...`. " +
+			"RSA keys must have at least 1024 bits, and Ed25519 keys are encoded as specified by RFC 8463.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "public_key",
+				MarkdownDescription: "The PEM-encoded RSA or Ed25519 public key, such as the `public_key_pem` attribute of a `tls_private_key` resource.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DKIMFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var publicKey string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &publicKey))
+	if resp.Error != nil {
+		return
+	}
+
+	key, err := mailauth.DKIMKeyFromPEM(publicKey)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid public key: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, key.String()))
+}
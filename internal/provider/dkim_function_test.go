@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDKIMFunction(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	got, funcErr := runFunction(t, NewDKIMFunction(), types.StringValue(keyPEM))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+	want := "v=DKIM1; k=ed25519; p=" + base64.StdEncoding.EncodeToString(publicKey)
+	if !got.Equal(types.StringValue(want)) {
+		t.Errorf("Run() = %v, want %q", got, want)
+	}
+
+	_, funcErr = runFunction(t, NewDKIMFunction(), types.StringValue("not a key"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "no PEM-encoded public key found") {
+		t.Errorf("Run() error = %v, want invalid key", funcErr)
+	}
+}
@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+)
+
+var _ function.Function = &DMARCFunction{}
+
+func NewDMARCFunction() function.Function {
+	return &DMARCFunction{}
+}
+
+// DMARCFunction builds and validates the value of a DMARC TXT record.
+type DMARCFunction struct{}
+
+func (f *DMARCFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dmarc"
+}
+
+func (f *DMARCFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a DMARC record value",
+		MarkdownDescription: "Builds the value of a DMARC TXT record (RFC 7489) to publish at `_dmarc`. " +
+			"All tags are validated, and report addresses must be email addresses or `mailto:` URIs.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "config",
+				MarkdownDescription: "An object with the following attributes: " +
+					"`p` (string, required) is the policy for failing mail (`none`, `quarantine` or `reject`); " +
+					"`sp` (string) is the policy for subdomains; " +
+					"`pct` (number) is the percentage of messages the policy applies to; " +
+					"`rua` and `ruf` (list of string) list the addresses for aggregate and failure reports, plain email addresses are converted to `mailto:` URIs; " +
+					"`adkim` and `aspf` (string) are the DKIM and SPF alignment modes (`r` or `s`); " +
+					"`fo` (string) are the failure reporting options, such as `1` or `d:s`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *DMARCFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &config))
+	if resp.Error != nil {
+		return
+	}
+
+	dmarc, funcErr := dmarcFromArgument(config)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if err := dmarc.Validate(); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid DMARC record: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, dmarc.String()))
+}
+
+// dmarcFromArgument converts the config argument to a DMARC record.
+func dmarcFromArgument(config types.Dynamic) (mailauth.DMARC, *function.FuncError) {
+	args, funcErr := newObjectArgument(config, 0, "p", "sp", "pct", "rua", "ruf", "adkim", "aspf", "fo")
+	if funcErr != nil {
+		return mailauth.DMARC{}, funcErr
+	}
+
+	var dmarc mailauth.DMARC
+	var errs [8]*function.FuncError
+	dmarc.Policy, errs[0] = args.String("p")
+	dmarc.SubdomainPolicy, errs[1] = args.String("sp")
+	dmarc.Percent, errs[2] = args.Int64("pct")
+	dmarc.RUA, errs[3] = args.Strings("rua")
+	dmarc.RUF, errs[4] = args.Strings("ruf")
+	dmarc.ADKIM, errs[5] = args.String("adkim")
+	dmarc.ASPF, errs[6] = args.String("aspf")
+	dmarc.FailureOptions, errs[7] = args.String("fo")
+	if errs[0] == nil && dmarc.Policy == "" {
+		errs[0] = args.Error("p", "the policy is required.")
+	}
+
+	return dmarc, function.ConcatFuncErrors(errs[:]...)
+}
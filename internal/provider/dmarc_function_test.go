@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDMARCFunction(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]attr.Value
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "minimal",
+			config: map[string]attr.Value{"p": types.StringValue("none")},
+			want:   "v=DMARC1; p=none",
+		},
+		{
+			name: "full",
+			config: map[string]attr.Value{
+				"p":     types.StringValue("reject"),
+				"sp":    types.StringValue("quarantine"),
+				"pct":   types.NumberValue(big.NewFloat(50)),
+				"rua":   stringTuple("dmarc@example.com"),
+				"ruf":   stringTuple("mailto:forensic@example.com!10m"),
+				"adkim": types.StringValue("s"),
+				"aspf":  types.StringValue("r"),
+				"fo":    types.StringValue("1"),
+			},
+			want: "v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com; ruf=mailto:forensic@example.com!10m; adkim=s; aspf=r; fo=1",
+		},
+		{
+			name:    "missing policy",
+			config:  map[string]attr.Value{"rua": stringTuple("dmarc@example.com")},
+			wantErr: `Invalid "p": the policy is required.`,
+		},
+		{
+			name: "invalid report address",
+			config: map[string]attr.Value{
+				"p":   types.StringValue("none"),
+				"rua": stringTuple("https://example.com/reports"),
+			},
+			wantErr: "must be an email address or mailto URI",
+		},
+		{
+			name: "fractional percentage",
+			config: map[string]attr.Value{
+				"p":   types.StringValue("none"),
+				"pct": types.NumberValue(big.NewFloat(12.5)),
+			},
+			wantErr: `Invalid "pct": expected a whole number.`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, funcErr := runFunction(t, NewDMARCFunction(), dynamicObject(tt.config))
+			if tt.wantErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tt.wantErr) {
+					t.Fatalf("Run() error = %v, want error containing %q", funcErr, tt.wantErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("Run() unexpected error: %v", funcErr)
+			}
+			if !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run() = %v, want %q", got, tt.want)
+			}
+		})
+	}
+}
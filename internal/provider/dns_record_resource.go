@@ -3,10 +3,16 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -15,16 +21,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
 
 	"github.com/tuzzmaniandevil/porkbun-go"
 
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
 	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
 	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/enumvalidator"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/subdomainvalidator"
 )
 
 var (
-	_ resource.Resource                = &DNSRecordResource{}
-	_ resource.ResourceWithImportState = &DNSRecordResource{}
+	_ resource.Resource                   = &DNSRecordResource{}
+	_ resource.ResourceWithImportState    = &DNSRecordResource{}
+	_ resource.ResourceWithValidateConfig = &DNSRecordResource{}
+	_ resource.ResourceWithModifyPlan     = &DNSRecordResource{}
+)
+
+const (
+	// Default values for the wait_for_propagation attribute.
+	propagationTimeoutDefault      = 300
+	propagationPollIntervalDefault = 10
+
+	// propagationQueryTimeout is the timeout for a single DNS query while waiting for propagation.
+	propagationQueryTimeout = 5 * time.Second
 )
 
 func NewDNSRecordResource() resource.Resource {
@@ -32,7 +53,8 @@ func NewDNSRecordResource() resource.Resource {
 }
 
 type DNSRecordResource struct {
-	client *porkbun.Client
+	client          *porkbun.Client
+	nameserverCheck *nameserverCheck
 }
 
 type DNSRecordResourceModel struct {
@@ -44,6 +66,14 @@ type DNSRecordResourceModel struct {
 	TTL       types.Int64  `tfsdk:"ttl"`
 	Prio      types.Int64  `tfsdk:"prio"`
 	Notes     types.String `tfsdk:"notes"`
+
+	WaitForPropagation *DNSRecordPropagationModel `tfsdk:"wait_for_propagation"`
+}
+
+type DNSRecordPropagationModel struct {
+	Timeout      types.Int64 `tfsdk:"timeout"`
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
+	Resolvers    types.List  `tfsdk:"resolvers"`
 }
 
 func (r *DNSRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,25 +101,15 @@ func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaReque
 			"subdomain": schema.StringAttribute{
 				MarkdownDescription: "The subdomain for the record being created, not including the domain itself. Leave blank to create a record on the root domain. Use * to create a wildcard record.",
 				Required:            true,
+				Validators: []validator.String{
+					subdomainvalidator.Valid(),
+				},
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "The type of DNS record to create (A, AAAA, CNAME, MX, TXT, NS, ALIAS, SRV, TLSA, CAA, HTTPS, SVCB).",
 				Required:            true,
 				Validators: []validator.String{
-					enumvalidator.Valid(
-						porkbun.A,
-						porkbun.MX,
-						porkbun.CNAME,
-						porkbun.ALIAS,
-						porkbun.TXT,
-						porkbun.NS,
-						porkbun.AAAA,
-						porkbun.SRV,
-						porkbun.TLSA,
-						porkbun.CAA,
-						porkbun.HTTPS,
-						porkbun.SVCB,
-					),
+					enumvalidator.Valid(dnsRecordTypes...),
 				},
 			},
 			"content": schema.StringAttribute{
@@ -118,12 +138,69 @@ func (r *DNSRecordResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_propagation": schema.SingleNestedAttribute{
+				MarkdownDescription: "When set, create and update operations block until the record is served by all `resolvers`. " +
+					"If waiting fails after the record was created, the resource is marked as tainted. Not supported for `ALIAS` records, which are flattened by the nameserver.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Maximum time to wait for the record to propagate, in seconds. Defaults to %d.", propagationTimeoutDefault),
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(propagationTimeoutDefault),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"poll_interval": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Time between two polls of the resolvers, in seconds. Defaults to %d.", propagationPollIntervalDefault),
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(propagationPollIntervalDefault),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"resolvers": schema.ListAttribute{
+						MarkdownDescription: "Addresses of the DNS servers to query, as `host` or `host:port`. Defaults to the authoritative nameservers of the domain.",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func (r *DNSRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+	r.nameserverCheck = getNameserverCheck(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *DNSRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSRecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType := porkbun.DnsRecordType(data.Type.ValueString())
+	if data.Type.IsUnknown() || data.Subdomain.IsUnknown() || data.Content.IsUnknown() || data.Prio.IsUnknown() ||
+		!slices.Contains(dnsRecordTypes, recordType) {
+		return
+	}
+
+	for _, problem := range validateDNSRecordContent(recordType, data.Subdomain.ValueString(), data.Content.ValueString(), data.Prio.ValueInt64()) {
+		resp.Diagnostics.AddAttributeError(path.Root(problem.Attribute), "Invalid DNS Record", problem.Message)
+	}
+}
+
+func (r *DNSRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.nameserverCheck.checkPlan(ctx, req, &resp.Diagnostics)
 }
 
 func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -150,6 +227,11 @@ func (r *DNSRecordResource) Create(ctx context.Context, req resource.CreateReque
 	data.ID = types.Int64Value(apiResp.ID)
 	data.Notes = types.StringValue("") // empty on create
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForPropagation(ctx, &data, &resp.Diagnostics)
 }
 
 func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -174,7 +256,7 @@ func (r *DNSRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	subdomain, err := r.subdomainFromDomain(record.Name)
+	subdomain, err := r.subdomainFromDomain(record.Name, data.Domain.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error Parsing Subdomain", err.Error())
 		return
@@ -214,6 +296,11 @@ func (r *DNSRecordResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.waitForPropagation(ctx, &data, &resp.Diagnostics)
 }
 
 func (r *DNSRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -272,17 +359,105 @@ func (r *DNSRecordResource) getDNSRecord(ctx context.Context, domain string, id
 	}
 }
 
-// subdomainFromDomain extracts the subdomain from the full domain name.
-func (r *DNSRecordResource) subdomainFromDomain(name string) (string, error) {
-	parts := strings.Split(name, ".")
-	if len(parts) < 2 {
-		return "", fmt.Errorf("invalid domain name: %q", name)
+// subdomainFromDomain extracts the subdomain from the fully-qualified record name.
+func (r *DNSRecordResource) subdomainFromDomain(name, domain string) (string, error) {
+	subdomain, ok := dnsname.Subdomain(name, domain)
+	if !ok {
+		return "", fmt.Errorf("record name %q is not within domain %q", name, domain)
+	}
+	return subdomain, nil
+}
+
+// waitForPropagation blocks until the record is served by all configured resolvers.
+//
+// It is a no-op if wait_for_propagation is not set.
+func (r *DNSRecordResource) waitForPropagation(ctx context.Context, data *DNSRecordResourceModel, diagnostics *diag.Diagnostics) {
+	if data.WaitForPropagation == nil {
+		return
 	}
 
-	subdomain := strings.Join(parts[:len(parts)-2], ".")
-	if subdomain == "" {
-		return "", nil
+	if porkbun.DnsRecordType(data.Type.ValueString()) == porkbun.ALIAS {
+		diagnostics.AddAttributeWarning(
+			path.Root("wait_for_propagation"),
+			"Propagation Check Skipped",
+			"ALIAS records are flattened into A and AAAA records by the nameserver and cannot be compared to the configured content.",
+		)
+		return
 	}
 
-	return subdomain, nil
+	want, err := expectedDNSRecordRR(data)
+	if err != nil {
+		diagnostics.AddError("Error Waiting for DNS Propagation", err.Error())
+		return
+	}
+
+	servers, err := extractNameservers(data.WaitForPropagation.Resolvers)
+	if err != nil {
+		diagnostics.AddError("Error Extracting Resolvers", err.Error())
+		return
+	}
+	if len(servers) == 0 {
+		nsResp, err := r.client.Domains.GetNameServers(ctx, data.Domain.ValueString())
+		if err != nil {
+			diagnostics.AddError("Error Reading Nameservers", err.Error())
+			return
+		}
+		servers = nsResp.NS
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(data.WaitForPropagation.Timeout.ValueInt64())*time.Second)
+	defer cancel()
+
+	interval := time.Duration(data.WaitForPropagation.PollInterval.ValueInt64()) * time.Second
+	if err := resolver.NewClient(propagationQueryTimeout).WaitForRR(ctx, servers, want, interval); err != nil {
+		diagnostics.AddError("Error Waiting for DNS Propagation", err.Error())
+	}
+}
+
+// expectedDNSRecordRR converts the record into the resource record a nameserver is expected to serve.
+func expectedDNSRecordRR(data *DNSRecordResourceModel) (dns.RR, error) {
+	name := dnsname.Join(data.Subdomain.ValueString(), data.Domain.ValueString())
+
+	recordType := porkbun.DnsRecordType(data.Type.ValueString())
+	rdata := data.Content.ValueString()
+	switch recordType {
+	case porkbun.MX, porkbun.SRV:
+		// The priority is stored separately by Porkbun but is part of the RDATA.
+		rdata = strconv.FormatInt(data.Prio.ValueInt64(), 10) + " " + rdata
+	case porkbun.TXT:
+		rdata = quoteTXT(rdata)
+	}
+
+	rr, err := dns.NewRR(fmt.Sprintf("%s %d IN %s %s", dns.Fqdn(name), data.TTL.ValueInt64(), recordType, rdata))
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s record content %q: %w", recordType, data.Content.ValueString(), err)
+	}
+	if rr == nil {
+		return nil, fmt.Errorf("empty %s record content", recordType)
+	}
+	return rr, nil
+}
+
+// quoteTXT converts TXT content into zone file presentation format, splitting it
+// into character strings of at most 255 bytes. Content that is already quoted is
+// returned unchanged.
+func quoteTXT(content string) string {
+	if strings.HasPrefix(content, `"`) {
+		return content
+	}
+
+	const maxLen = 255
+	var parts []string
+	for len(content) > maxLen {
+		parts = append(parts, content[:maxLen])
+		content = content[maxLen:]
+	}
+	parts = append(parts, content)
+
+	for i, part := range parts {
+		part = strings.ReplaceAll(part, `\`, `\\`)
+		part = strings.ReplaceAll(part, `"`, `\"`)
+		parts[i] = `"` + part + `"`
+	}
+	return strings.Join(parts, " ")
 }
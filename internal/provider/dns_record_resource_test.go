@@ -1,14 +1,20 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver/resolvertest"
 )
 
 func TestAccDNSRecordResource(t *testing.T) {
@@ -114,7 +120,8 @@ resource "porkbun_dns_record" "test" {
 
 func TestDNSRecordResource_subdomainFromDomain(t *testing.T) {
 	type args struct {
-		name string
+		name   string
+		domain string
 	}
 	tests := []struct {
 		name    string
@@ -122,15 +129,16 @@ func TestDNSRecordResource_subdomainFromDomain(t *testing.T) {
 		want    string
 		wantErr bool
 	}{
-		{"simple", args{"example.com"}, "", false},
-		{"subdomain", args{"foo.example.com"}, "foo", false},
-		{"multiple subdomains", args{"foo.bar.example.com"}, "foo.bar", false},
-		{"invalid domain", args{"example"}, "", true},
+		{"simple", args{"example.com", "example.com"}, "", false},
+		{"subdomain", args{"foo.example.com", "example.com"}, "foo", false},
+		{"multiple subdomains", args{"foo.bar.example.com", "example.com"}, "foo.bar", false},
+		{"multi-label suffix", args{"foo.example.co.uk", "example.co.uk"}, "foo", false},
+		{"other domain", args{"example", "example.com"}, "", true},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			r := &DNSRecordResource{}
-			got, err := r.subdomainFromDomain(tt.args.name)
+			got, err := r.subdomainFromDomain(tt.args.name, tt.args.domain)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("subdomainFromDomain() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -141,3 +149,102 @@ func TestDNSRecordResource_subdomainFromDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestExpectedDNSRecordRR(t *testing.T) {
+	tests := []struct {
+		name       string
+		subdomain  string
+		recordType porkbun.DnsRecordType
+		content    string
+		prio       int64
+		want       string
+		wantErr    bool
+	}{
+		{"a", "www", porkbun.A, "192.0.2.1", 0, "www.example.com.\t600\tIN\tA\t192.0.2.1", false},
+		{"root", "", porkbun.AAAA, "2001:db8::1", 0, "example.com.\t600\tIN\tAAAA\t2001:db8::1", false},
+		{"wildcard", "*", porkbun.CNAME, "example.net", 0, "*.example.com.\t600\tIN\tCNAME\texample.net.", false},
+		{"mx", "", porkbun.MX, "mail.example.com", 10, "example.com.\t600\tIN\tMX\t10 mail.example.com.", false},
+		{"srv", "_sip._tcp", porkbun.SRV, "5 5060 sip.example.com", 10, "_sip._tcp.example.com.\t600\tIN\tSRV\t10 5 5060 sip.example.com.", false},
+		{"txt", "", porkbun.TXT, `v=spf1 include:"x" -all`, 0, `example.com.` + "\t600\tIN\tTXT\t" + `"v=spf1 include:\"x\" -all"`, false},
+		{"quoted txt", "", porkbun.TXT, `"a" "b"`, 0, "example.com.\t600\tIN\tTXT\t\"a\" \"b\"", false},
+		{"caa", "", porkbun.CAA, `0 issue "letsencrypt.org"`, 0, "example.com.\t600\tIN\tCAA\t0 issue \"letsencrypt.org\"", false},
+		{"invalid", "", porkbun.A, "not-an-ip", 0, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expectedDNSRecordRR(&DNSRecordResourceModel{
+				Domain:    types.StringValue("example.com"),
+				Subdomain: types.StringValue(tt.subdomain),
+				Type:      types.StringValue(string(tt.recordType)),
+				Content:   types.StringValue(tt.content),
+				TTL:       types.Int64Value(600),
+				Prio:      types.Int64Value(tt.prio),
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expectedDNSRecordRR() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.String() != tt.want {
+				t.Errorf("expectedDNSRecordRR() got = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestQuoteTXT(t *testing.T) {
+	long := make([]byte, 300)
+	for i := range long {
+		long[i] = 'a'
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"simple", "hello world", `"hello world"`},
+		{"escaped", `say "hi" \o/`, `"say \"hi\" \\o/"`},
+		{"already quoted", `"a" "b"`, `"a" "b"`},
+		{"split", string(long), `"` + string(long[:255]) + `" "` + string(long[255:]) + `"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quoteTXT(tt.content); got != tt.want {
+				t.Errorf("quoteTXT() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNSRecordResource_waitForPropagation(t *testing.T) {
+	server := resolvertest.NewServer(t, `acctest.example.com. 600 IN TXT "content"`)
+
+	newModel := func(content string) *DNSRecordResourceModel {
+		return &DNSRecordResourceModel{
+			Domain:    types.StringValue("example.com"),
+			Subdomain: types.StringValue("acctest"),
+			Type:      types.StringValue(string(porkbun.TXT)),
+			Content:   types.StringValue(content),
+			TTL:       types.Int64Value(600),
+			Prio:      types.Int64Value(0),
+			WaitForPropagation: &DNSRecordPropagationModel{
+				Timeout:      types.Int64Value(1),
+				PollInterval: types.Int64Value(1),
+				Resolvers:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue(server.Addr)}),
+			},
+		}
+	}
+
+	r := &DNSRecordResource{}
+
+	var diags diag.Diagnostics
+	r.waitForPropagation(context.Background(), newModel("content"), &diags)
+	if diags.HasError() {
+		t.Fatalf("waitForPropagation() unexpected error: %v", diags)
+	}
+
+	diags = nil
+	r.waitForPropagation(context.Background(), newModel("other content"), &diags)
+	if !diags.HasError() {
+		t.Fatal("waitForPropagation() expected error, got none")
+	}
+}
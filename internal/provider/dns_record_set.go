@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+// dnsRecordSetObjectAttrs defines the attributes for a record managed as part of a record set.
+var dnsRecordSetObjectAttrs = map[string]attr.Type{
+	"id":        types.Int64Type,
+	"subdomain": types.StringType,
+	"type":      types.StringType,
+	"content":   types.StringType,
+	"ttl":       types.Int64Type,
+	"prio":      types.Int64Type,
+}
+
+// dnsRecordSetEntry is a single DNS record managed by a resource that owns several records as one unit.
+type dnsRecordSetEntry struct {
+	// ID is the Porkbun record ID, or 0 if the record has not been created yet.
+	ID        int64
+	Subdomain string
+	Type      porkbun.DnsRecordType
+	Content   string
+	TTL       int64
+	Prio      int64
+}
+
+// sameRecord reports whether both entries describe the same record, ignoring the ID.
+func (e dnsRecordSetEntry) sameRecord(other dnsRecordSetEntry) bool {
+	return e.Subdomain == other.Subdomain &&
+		e.Type == other.Type &&
+		e.Content == other.Content &&
+		e.TTL == other.TTL &&
+		e.Prio == other.Prio
+}
+
+// matchDNSRecordSet pairs the desired records with existing ones.
+//
+// Desired records that are identical to an existing record keep its ID. Remaining
+// desired records take over the ID of an unmatched existing record with the same
+// subdomain and type, so they can be edited in place. All other desired records
+// are returned with ID 0. The existing records that were not matched are returned
+// as obsolete.
+func matchDNSRecordSet(existing, desired []dnsRecordSetEntry) (planned, obsolete []dnsRecordSetEntry) {
+	used := make([]bool, len(existing))
+	planned = make([]dnsRecordSetEntry, len(desired))
+	copy(planned, desired)
+
+	matchBy := func(match func(existing, desired dnsRecordSetEntry) bool) {
+		for i := range planned {
+			if planned[i].ID != 0 {
+				continue
+			}
+			for j := range existing {
+				if !used[j] && match(existing[j], planned[i]) {
+					used[j] = true
+					planned[i].ID = existing[j].ID
+					break
+				}
+			}
+		}
+	}
+	matchBy(dnsRecordSetEntry.sameRecord)
+	matchBy(func(e, d dnsRecordSetEntry) bool { return e.Subdomain == d.Subdomain && e.Type == d.Type })
+
+	for j := range existing {
+		if !used[j] {
+			obsolete = append(obsolete, existing[j])
+		}
+	}
+	return planned, obsolete
+}
+
+// applyDNSRecordSet converges the existing records of a domain to the desired records.
+//
+// New records are created before obsolete ones are deleted. The returned entries
+// reflect every change that was applied, even if an error occurred part way through,
+// so they can be persisted to state.
+func applyDNSRecordSet(ctx context.Context, client *porkbun.Client, domain string, existing, desired []dnsRecordSetEntry) ([]dnsRecordSetEntry, error) {
+	planned, obsolete := matchDNSRecordSet(existing, desired)
+
+	byID := make(map[int64]dnsRecordSetEntry, len(existing))
+	for _, e := range existing {
+		byID[e.ID] = e
+	}
+
+	// result tracks the records that exist in Porkbun after each step.
+	var result []dnsRecordSetEntry
+	for i, entry := range planned {
+		switch current, ok := byID[entry.ID]; {
+		case entry.ID == 0:
+			resp, err := client.Dns.CreateRecord(ctx, domain, &porkbun.DnsRecord{
+				Name:    entry.Subdomain,
+				Type:    entry.Type,
+				Content: entry.Content,
+				TTL:     strconv.FormatInt(entry.TTL, 10),
+				Prio:    strconv.FormatInt(entry.Prio, 10),
+			})
+			if err != nil {
+				result = append(result, keepUnapplied(planned[i:], byID)...)
+				return append(result, obsolete...), fmt.Errorf("error creating %s record for %q: %w", entry.Type, entry.Subdomain, err)
+			}
+			entry.ID = resp.ID
+		case ok && !current.sameRecord(entry):
+			_, err := client.Dns.EditRecord(ctx, domain, entry.ID, &porkbun.EditRecord{
+				Name:    entry.Subdomain,
+				Type:    entry.Type,
+				Content: entry.Content,
+				TTL:     strconv.FormatInt(entry.TTL, 10),
+				Prio:    strconv.FormatInt(entry.Prio, 10),
+			})
+			if err != nil {
+				result = append(result, keepUnapplied(planned[i:], byID)...)
+				return append(result, obsolete...), fmt.Errorf("error updating %s record %d: %w", entry.Type, entry.ID, err)
+			}
+		}
+		result = append(result, entry)
+	}
+
+	remaining, err := deleteDNSRecordSet(ctx, client, domain, obsolete)
+	if err != nil {
+		// Obsolete records that could not be deleted are still managed by the resource.
+		return append(result, remaining...), err
+	}
+
+	return result, nil
+}
+
+// keepUnapplied returns the existing state of records whose planned changes were not applied.
+func keepUnapplied(planned []dnsRecordSetEntry, existing map[int64]dnsRecordSetEntry) []dnsRecordSetEntry {
+	var result []dnsRecordSetEntry
+	for _, entry := range planned {
+		if current, ok := existing[entry.ID]; ok {
+			result = append(result, current)
+		}
+	}
+	return result
+}
+
+// readDNSRecordSet fetches the current state of the given records. Records that
+// no longer exist are dropped from the result.
+func readDNSRecordSet(ctx context.Context, client *porkbun.Client, domain string, entries []dnsRecordSetEntry, diagnostics *diag.Diagnostics) ([]dnsRecordSetEntry, error) {
+	apiResp, err := client.Dns.GetRecords(ctx, domain, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching DNS records for domain %q: %w", domain, err)
+	}
+
+	byID := make(map[int64]porkbun.DnsRecord, len(apiResp.Records))
+	for _, record := range apiResp.Records {
+		if record.ID != nil {
+			byID[*record.ID] = record
+		}
+	}
+
+	result := make([]dnsRecordSetEntry, 0, len(entries))
+	for _, entry := range entries {
+		record, ok := byID[entry.ID]
+		if !ok {
+			continue
+		}
+		subdomain, _ := dnsname.Subdomain(record.Name, domain)
+		result = append(result, dnsRecordSetEntry{
+			ID:        entry.ID,
+			Subdomain: subdomain,
+			Type:      record.Type,
+			Content:   record.Content,
+			TTL:       util.Int64Value(record.TTL, diagnostics).ValueInt64(),
+			Prio:      util.Int64Value(record.Prio, diagnostics).ValueInt64(),
+		})
+	}
+	return result, nil
+}
+
+// deleteDNSRecordSet deletes all given records, returning the ones that could not be deleted.
+func deleteDNSRecordSet(ctx context.Context, client *porkbun.Client, domain string, entries []dnsRecordSetEntry) ([]dnsRecordSetEntry, error) {
+	for i, entry := range entries {
+		if _, err := client.Dns.DeleteRecord(ctx, domain, entry.ID); err != nil {
+			return entries[i:], fmt.Errorf("error deleting %s record %d: %w", entry.Type, entry.ID, err)
+		}
+	}
+	return nil, nil
+}
+
+// dnsRecordSetToList converts record set entries to a types.List. Entries that
+// have not been created yet have an unknown ID.
+func dnsRecordSetToList(entries []dnsRecordSetEntry) types.List {
+	return util.MustMapToList(entries, types.ObjectType{AttrTypes: dnsRecordSetObjectAttrs}, func(entry dnsRecordSetEntry) attr.Value {
+		id := types.Int64Unknown()
+		if entry.ID != 0 {
+			id = types.Int64Value(entry.ID)
+		}
+		return types.ObjectValueMust(dnsRecordSetObjectAttrs, map[string]attr.Value{
+			"id":        id,
+			"subdomain": types.StringValue(entry.Subdomain),
+			"type":      types.StringValue(string(entry.Type)),
+			"content":   types.StringValue(entry.Content),
+			"ttl":       types.Int64Value(entry.TTL),
+			"prio":      types.Int64Value(entry.Prio),
+		})
+	})
+}
+
+// dnsRecordSetFromList converts a types.List created by dnsRecordSetToList back to entries.
+func dnsRecordSetFromList(ctx context.Context, list types.List) ([]dnsRecordSetEntry, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []struct {
+		ID        types.Int64  `tfsdk:"id"`
+		Subdomain types.String `tfsdk:"subdomain"`
+		Type      types.String `tfsdk:"type"`
+		Content   types.String `tfsdk:"content"`
+		TTL       types.Int64  `tfsdk:"ttl"`
+		Prio      types.Int64  `tfsdk:"prio"`
+	}
+	diags := list.ElementsAs(ctx, &models, false)
+
+	entries := make([]dnsRecordSetEntry, 0, len(models))
+	for _, m := range models {
+		entries = append(entries, dnsRecordSetEntry{
+			ID:        m.ID.ValueInt64(),
+			Subdomain: m.Subdomain.ValueString(),
+			Type:      porkbun.DnsRecordType(m.Type.ValueString()),
+			Content:   m.Content.ValueString(),
+			TTL:       m.TTL.ValueInt64(),
+			Prio:      m.Prio.ValueInt64(),
+		})
+	}
+	return entries, diags
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestMatchDNSRecordSet(t *testing.T) {
+	mx := dnsRecordSetEntry{Type: porkbun.MX, Content: "mx.example.net", TTL: 600, Prio: 10}
+	spf := dnsRecordSetEntry{Type: porkbun.TXT, Content: "v=spf1 -all", TTL: 600}
+	dmarc := dnsRecordSetEntry{Subdomain: "_dmarc", Type: porkbun.TXT, Content: "v=DMARC1; p=none", TTL: 600}
+
+	withID := func(e dnsRecordSetEntry, id int64) dnsRecordSetEntry {
+		e.ID = id
+		return e
+	}
+	withContent := func(e dnsRecordSetEntry, content string) dnsRecordSetEntry {
+		e.Content = content
+		return e
+	}
+
+	tests := []struct {
+		name         string
+		existing     []dnsRecordSetEntry
+		desired      []dnsRecordSetEntry
+		wantPlanned  []dnsRecordSetEntry
+		wantObsolete []dnsRecordSetEntry
+	}{
+		{
+			name:        "create",
+			desired:     []dnsRecordSetEntry{mx, spf},
+			wantPlanned: []dnsRecordSetEntry{mx, spf},
+		},
+		{
+			name:        "unchanged",
+			existing:    []dnsRecordSetEntry{withID(spf, 2), withID(mx, 1)},
+			desired:     []dnsRecordSetEntry{mx, spf},
+			wantPlanned: []dnsRecordSetEntry{withID(mx, 1), withID(spf, 2)},
+		},
+		{
+			name:        "edit in place",
+			existing:    []dnsRecordSetEntry{withID(mx, 1), withID(withContent(spf, "v=spf1 ~all"), 2)},
+			desired:     []dnsRecordSetEntry{mx, spf},
+			wantPlanned: []dnsRecordSetEntry{withID(mx, 1), withID(spf, 2)},
+		},
+		{
+			name:         "replace and remove",
+			existing:     []dnsRecordSetEntry{withID(mx, 1), withID(dmarc, 3)},
+			desired:      []dnsRecordSetEntry{mx, spf},
+			wantPlanned:  []dnsRecordSetEntry{withID(mx, 1), spf},
+			wantObsolete: []dnsRecordSetEntry{withID(dmarc, 3)},
+		},
+		{
+			name:         "exact matches take precedence",
+			existing:     []dnsRecordSetEntry{withID(withContent(mx, "old.example.net"), 1), withID(mx, 2)},
+			desired:      []dnsRecordSetEntry{mx},
+			wantPlanned:  []dnsRecordSetEntry{withID(mx, 2)},
+			wantObsolete: []dnsRecordSetEntry{withID(withContent(mx, "old.example.net"), 1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned, obsolete := matchDNSRecordSet(tt.existing, tt.desired)
+			if !reflect.DeepEqual(planned, tt.wantPlanned) {
+				t.Errorf("matchDNSRecordSet() planned = %v, want %v", planned, tt.wantPlanned)
+			}
+			if !reflect.DeepEqual(obsolete, tt.wantObsolete) {
+				t.Errorf("matchDNSRecordSet() obsolete = %v, want %v", obsolete, tt.wantObsolete)
+			}
+		})
+	}
+}
+
+func TestApplyDNSRecordSet(t *testing.T) {
+	api := newFakeAPI()
+	client := newTestClient(t, api)
+	ctx := context.Background()
+
+	mx := dnsRecordSetEntry{Type: porkbun.MX, Content: "mx.example.net", TTL: 600, Prio: 10}
+	spf := dnsRecordSetEntry{Type: porkbun.TXT, Content: "v=spf1 -all", TTL: 600}
+	dmarc := dnsRecordSetEntry{Subdomain: "_dmarc", Type: porkbun.TXT, Content: "v=DMARC1; p=none", TTL: 600}
+
+	created, err := applyDNSRecordSet(ctx, client, "example.com", nil, []dnsRecordSetEntry{mx, spf})
+	if err != nil {
+		t.Fatalf("applyDNSRecordSet() unexpected error: %v", err)
+	}
+	if len(created) != 2 || created[0].ID == 0 || created[1].ID == 0 {
+		t.Fatalf("applyDNSRecordSet() = %v, want two created records", created)
+	}
+
+	// Simulate drift on the SPF record.
+	api.records["example.com"][1].Content = "v=spf1 +all"
+
+	var diags diag.Diagnostics
+	read, err := readDNSRecordSet(ctx, client, "example.com", created, &diags)
+	if err != nil || diags.HasError() {
+		t.Fatalf("readDNSRecordSet() unexpected error: %v %v", err, diags)
+	}
+	if read[1].Content != "v=spf1 +all" {
+		t.Errorf("readDNSRecordSet() did not detect drift: %v", read[1])
+	}
+
+	updated, err := applyDNSRecordSet(ctx, client, "example.com", read, []dnsRecordSetEntry{spf, dmarc})
+	if err != nil {
+		t.Fatalf("applyDNSRecordSet() unexpected error: %v", err)
+	}
+	if updated[0].ID != created[1].ID {
+		t.Errorf("applyDNSRecordSet() did not update SPF record in place: %v", updated)
+	}
+
+	records := api.records["example.com"]
+	if len(records) != 2 {
+		t.Fatalf("fake API has %d records, want 2: %v", len(records), records)
+	}
+	if records[0].Content != spf.Content || records[1].Name != "_dmarc.example.com" {
+		t.Errorf("fake API records = %v, want SPF and DMARC", records)
+	}
+
+	if _, err := deleteDNSRecordSet(ctx, client, "example.com", updated); err != nil {
+		t.Fatalf("deleteDNSRecordSet() unexpected error: %v", err)
+	}
+	if len(api.records["example.com"]) != 0 {
+		t.Errorf("fake API still has records after delete: %v", api.records["example.com"])
+	}
+}
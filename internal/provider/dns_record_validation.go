@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/miekg/dns"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/enumvalidator"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/subdomainvalidator"
+)
+
+// dnsRecordTypes lists the record types supported by porkbun_dns_record.
+var dnsRecordTypes = []porkbun.DnsRecordType{
+	porkbun.A,
+	porkbun.MX,
+	porkbun.CNAME,
+	porkbun.ALIAS,
+	porkbun.TXT,
+	porkbun.NS,
+	porkbun.AAAA,
+	porkbun.SRV,
+	porkbun.TLSA,
+	porkbun.CAA,
+	porkbun.HTTPS,
+	porkbun.SVCB,
+}
+
+// dnsRecordContentFormats describes the expected content of record types whose
+// content is validated by parsing it as a resource record.
+var dnsRecordContentFormats = map[porkbun.DnsRecordType]string{
+	porkbun.SRV:   "<weight> <port> <target>",
+	porkbun.TLSA:  "<usage> <selector> <matching type> <hex data>",
+	porkbun.CAA:   `<flags> <tag> "<value>"`,
+	porkbun.HTTPS: "<priority> <target> [<key>=<value> ...]",
+	porkbun.SVCB:  "<priority> <target> [<key>=<value> ...]",
+}
+
+// dnsRecordProblem is a problem with an argument of a DNS record.
+type dnsRecordProblem struct {
+	// Attribute is the name of the argument.
+	Attribute string
+	Message   string
+}
+
+func (p dnsRecordProblem) String() string {
+	return p.Attribute + ": " + p.Message
+}
+
+// validateDNSRecord checks all arguments of a DNS record using the validators
+// of the porkbun_dns_record schema and the content rules of validateDNSRecordContent.
+func validateDNSRecord(ctx context.Context, recordType, subdomain, content string, prio int64) []dnsRecordProblem {
+	var problems []dnsRecordProblem
+	appendDiags := func(attribute string, diags diag.Diagnostics) {
+		for _, d := range diags.Errors() {
+			problems = append(problems, dnsRecordProblem{Attribute: attribute, Message: d.Detail()})
+		}
+	}
+
+	typeResp := &validator.StringResponse{}
+	enumvalidator.Valid(dnsRecordTypes...).ValidateString(ctx, validator.StringRequest{
+		Path:        path.Root("type"),
+		ConfigValue: types.StringValue(recordType),
+	}, typeResp)
+	appendDiags("type", typeResp.Diagnostics)
+
+	subdomainResp := &validator.StringResponse{}
+	subdomainvalidator.Valid().ValidateString(ctx, validator.StringRequest{
+		Path:        path.Root("subdomain"),
+		ConfigValue: types.StringValue(subdomain),
+	}, subdomainResp)
+	appendDiags("subdomain", subdomainResp.Diagnostics)
+
+	if !typeResp.Diagnostics.HasError() {
+		problems = append(problems, validateDNSRecordContent(porkbun.DnsRecordType(recordType), subdomain, content, prio)...)
+	}
+	return problems
+}
+
+// validateDNSRecordContent checks the content and priority of a DNS record of a supported type.
+func validateDNSRecordContent(recordType porkbun.DnsRecordType, subdomain, content string, prio int64) []dnsRecordProblem {
+	var problems []dnsRecordProblem
+	addProblem := func(attribute, format string, args ...any) {
+		problems = append(problems, dnsRecordProblem{Attribute: attribute, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if prio < 0 || prio > 65535 {
+		addProblem("prio", "Priority %d must be between 0 and 65535.", prio)
+	}
+	if recordType == porkbun.CNAME && subdomain == "" {
+		addProblem("type", "CNAME records cannot be created for the domain itself, use an ALIAS record instead.")
+	}
+	if content == "" {
+		addProblem("content", "Content must not be empty.")
+		return problems
+	}
+
+	switch recordType {
+	case porkbun.A:
+		if addr, err := netip.ParseAddr(content); err != nil || !addr.Is4() {
+			addProblem("content", "%q is not an IPv4 address.", content)
+		}
+	case porkbun.AAAA:
+		if addr, err := netip.ParseAddr(content); err != nil || !addr.Is6() || addr.Is4In6() {
+			addProblem("content", "%q is not an IPv6 address.", content)
+		}
+	case porkbun.CNAME, porkbun.ALIAS, porkbun.NS:
+		if !isHostName(content) {
+			addProblem("content", "%q is not a valid host name.", content)
+		}
+	case porkbun.MX:
+		// A single dot is a null MX record (RFC 7505).
+		if content != "." && !isHostName(content) {
+			addProblem("content", "%q is not a valid host name.", content)
+		}
+	case porkbun.SRV:
+		if !strings.HasPrefix(subdomain, "_") {
+			addProblem("subdomain", "SRV records must be named _<service>._<protocol>, for example _sip._tcp.")
+		}
+		fallthrough
+	case porkbun.TLSA, porkbun.CAA, porkbun.HTTPS, porkbun.SVCB:
+		rdata := content
+		if recordType == porkbun.SRV {
+			rdata = "0 " + rdata
+		}
+		if rr, err := dns.NewRR(fmt.Sprintf(". 600 IN %s %s", recordType, rdata)); err != nil || rr == nil {
+			addProblem("content", "%q is not valid %s record content, expected %s.", content, recordType, dnsRecordContentFormats[recordType])
+		}
+	}
+	return problems
+}
+
+// isHostName reports whether name is a fully-qualified host name without wildcards.
+func isHostName(name string) bool {
+	ascii, err := dnsname.ToASCII(name)
+	return err == nil && strings.Contains(ascii, ".") && !strings.Contains(ascii, "*")
+}
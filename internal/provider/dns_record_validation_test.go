@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestValidateDNSRecordContent(t *testing.T) {
+	tests := []struct {
+		name       string
+		recordType porkbun.DnsRecordType
+		subdomain  string
+		content    string
+		prio       int64
+		want       []string
+	}{
+		{name: "A", recordType: porkbun.A, content: "192.0.2.1"},
+		{name: "A with IPv6", recordType: porkbun.A, content: "2001:db8::1", want: []string{"content"}},
+		{name: "AAAA", recordType: porkbun.AAAA, subdomain: "www", content: "2001:db8::1"},
+		{name: "AAAA with IPv4", recordType: porkbun.AAAA, content: "192.0.2.1", want: []string{"content"}},
+		{name: "CNAME", recordType: porkbun.CNAME, subdomain: "www", content: "example.github.io"},
+		{name: "CNAME at apex", recordType: porkbun.CNAME, content: "example.github.io", want: []string{"type"}},
+		{name: "ALIAS with wildcard", recordType: porkbun.ALIAS, content: "*.example.net", want: []string{"content"}},
+		{name: "MX", recordType: porkbun.MX, content: "mail.example.com", prio: 10},
+		{name: "null MX", recordType: porkbun.MX, content: "."},
+		{name: "MX with invalid prio", recordType: porkbun.MX, content: "mail.example.com", prio: 70000, want: []string{"prio"}},
+		{name: "TXT", recordType: porkbun.TXT, content: `v=spf1 -all`},
+		{name: "empty content", recordType: porkbun.TXT, want: []string{"content"}},
+		{name: "SRV", recordType: porkbun.SRV, subdomain: "_sip._tcp", content: "10 5060 sip.example.com", prio: 5},
+		{name: "SRV without service name", recordType: porkbun.SRV, subdomain: "sip", content: "10 5060 sip.example.com", want: []string{"subdomain"}},
+		{name: "SRV with invalid content", recordType: porkbun.SRV, subdomain: "_sip._tcp", content: "sip.example.com", want: []string{"content"}},
+		{name: "CAA", recordType: porkbun.CAA, content: `0 issue "letsencrypt.org"`},
+		{name: "CAA with invalid flags", recordType: porkbun.CAA, content: `300 issue "letsencrypt.org"`, want: []string{"content"}},
+		{name: "TLSA", recordType: porkbun.TLSA, subdomain: "_25._tcp.mail", content: "3 1 1 0c72ac70b745ac19998811b131d662c9ac69dbdbe7cb23e5b514b56664c5d3d6"},
+		{name: "HTTPS", recordType: porkbun.HTTPS, content: "1 . alpn=h2,h3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, problem := range validateDNSRecordContent(tt.recordType, tt.subdomain, tt.content, tt.prio) {
+				got = append(got, problem.Attribute)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateDNSRecordContent() problems = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnssec"
+)
+
+var _ function.Function = &DNSSECDSFunction{}
+
+// dnssecDSAttrTypes defines the attributes of the object returned by DNSSECDSFunction.
+// They match the ds_data attribute of porkbun_dnssec_record.
+var dnssecDSAttrTypes = map[string]attr.Type{
+	"key_tag":     types.StringType,
+	"algorithm":   types.Int64Type,
+	"digest_type": types.Int64Type,
+	"digest":      types.StringType,
+}
+
+func NewDNSSECDSFunction() function.Function {
+	return &DNSSECDSFunction{}
+}
+
+// DNSSECDSFunction computes the DS record data for a DNSKEY record.
+type DNSSECDSFunction struct{}
+
+func (f *DNSSECDSFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "dnssec_ds"
+}
+
+func (f *DNSSECDSFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute DS record data from a DNSKEY",
+		MarkdownDescription: "Computes the Delegation Signer (DS) record data for a DNSKEY record, as specified by RFC 4034, RFC 4509 and RFC 6605. " +
+			"Returns an object with the attributes `key_tag` (as a decimal string), `algorithm`, `digest_type` and `digest` (upper-case hexadecimal), " +
+			"which can be used as the `ds_data` of a `porkbun_dnssec_record` resource.",
+		Parameters: []function.Parameter{
+			function.Int64Parameter{
+				Name:                "flags",
+				MarkdownDescription: "The DNSKEY flags field, usually `257` (KSK) or `256` (ZSK). The zone key flag (`256`) must be set.",
+			},
+			function.Int64Parameter{
+				Name:                "protocol",
+				MarkdownDescription: "The DNSKEY protocol field. Must be `3`.",
+			},
+			function.Int64Parameter{
+				Name:                "algorithm",
+				MarkdownDescription: "The DNSSEC algorithm identifier of the key, for example `13` (ECDSA P-256 with SHA-256).",
+			},
+			function.StringParameter{
+				Name:                "public_key",
+				MarkdownDescription: "The base64-encoded public key of the DNSKEY record.",
+			},
+			function.Int64Parameter{
+				Name:                "digest_type",
+				MarkdownDescription: "The digest algorithm: `1` (SHA-1), `2` (SHA-256) or `4` (SHA-384).",
+			},
+			function.StringParameter{
+				Name:                "owner",
+				MarkdownDescription: "The owner name of the DNSKEY record, i.e. the domain, for example `example.com`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: dnssecDSAttrTypes,
+		},
+	}
+}
+
+func (f *DNSSECDSFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var flags, protocol, algorithm, digestType int64
+	var publicKey, owner string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &flags, &protocol, &algorithm, &publicKey, &digestType, &owner))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(
+		checkUintArgument(0, "flags", flags, 16),
+		checkUintArgument(1, "protocol", protocol, 8),
+		checkUintArgument(2, "algorithm", algorithm, 8),
+		checkUintArgument(4, "digest_type", digestType, 8),
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	key := dnssec.Key{
+		Flags:     uint16(flags),
+		Protocol:  uint8(protocol),
+		Algorithm: uint8(algorithm),
+		PublicKey: publicKey,
+	}
+	ds, err := key.DS(owner, uint8(digestType))
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Invalid DNSKEY: %s", err))
+		return
+	}
+
+	result := types.ObjectValueMust(dnssecDSAttrTypes, map[string]attr.Value{
+		"key_tag":     types.StringValue(strconv.FormatUint(uint64(ds.KeyTag), 10)),
+		"algorithm":   types.Int64Value(int64(ds.Algorithm)),
+		"digest_type": types.Int64Value(int64(ds.DigestType)),
+		"digest":      types.StringValue(ds.Digest),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// checkUintArgument checks that the argument at the given position fits into an unsigned integer of the given size.
+func checkUintArgument(position int64, name string, value int64, bits int) *function.FuncError {
+	if value < 0 || value >= 1<<bits {
+		return function.NewArgumentFuncError(position, fmt.Sprintf("Invalid %q: must be between 0 and %d.", name, int64(1)<<bits-1))
+	}
+	return nil
+}
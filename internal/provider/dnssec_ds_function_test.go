@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDNSSECDSFunction(t *testing.T) {
+	// The P-256 example key from RFC 6605, section 6.1.
+	const publicKey = "GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA=="
+
+	got, funcErr := runFunction(t, NewDNSSECDSFunction(),
+		types.Int64Value(257), types.Int64Value(3), types.Int64Value(13),
+		types.StringValue(publicKey), types.Int64Value(2), types.StringValue("example.net"))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+
+	want := types.ObjectValueMust(dnssecDSAttrTypes, map[string]attr.Value{
+		"key_tag":     types.StringValue("55648"),
+		"algorithm":   types.Int64Value(13),
+		"digest_type": types.Int64Value(2),
+		"digest":      types.StringValue("B4C8C1FE2E7477127B27115656AD6256F424625BF5C1E2770CE6D6E37DF61D17"),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+
+	_, funcErr = runFunction(t, NewDNSSECDSFunction(),
+		types.Int64Value(257), types.Int64Value(3), types.Int64Value(13),
+		types.StringValue(publicKey), types.Int64Value(3), types.StringValue("example.net"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "unsupported digest type 3") {
+		t.Errorf("Run() error = %v, want unsupported digest type", funcErr)
+	}
+
+	_, funcErr = runFunction(t, NewDNSSECDSFunction(),
+		types.Int64Value(65536), types.Int64Value(3), types.Int64Value(13),
+		types.StringValue(publicKey), types.Int64Value(2), types.StringValue("example.net"))
+	if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 0 {
+		t.Errorf("Run() error = %v, want invalid flags", funcErr)
+	}
+}
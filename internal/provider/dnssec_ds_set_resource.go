@@ -0,0 +1,603 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/enumvalidator"
+)
+
+var (
+	_ resource.Resource                   = &DNSSECDSSetResource{}
+	_ resource.ResourceWithImportState    = &DNSSECDSSetResource{}
+	_ resource.ResourceWithValidateConfig = &DNSSECDSSetResource{}
+	_ resource.ResourceWithModifyPlan     = &DNSSECDSSetResource{}
+)
+
+// dnssecRetiredDSObjectAttrs defines the attributes for the retired DS record object.
+var dnssecRetiredDSObjectAttrs = map[string]attr.Type{
+	"key_tag":      types.StringType,
+	"algorithm":    types.Int64Type,
+	"digest_type":  types.Int64Type,
+	"digest":       types.StringType,
+	"remove_after": types.StringType,
+}
+
+func NewDNSSECDSSetResource() resource.Resource {
+	return &DNSSECDSSetResource{}
+}
+
+// DNSSECDSSetResource manages the complete set of DS records of a domain.
+type DNSSECDSSetResource struct {
+	client *porkbun.Client
+}
+
+type DNSSECDSSetResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Domain         types.String `tfsdk:"domain"`
+	DSRecords      types.Set    `tfsdk:"ds_records"`
+	AllowEmpty     types.Bool   `tfsdk:"allow_empty"`
+	HoldPeriod     types.Int64  `tfsdk:"hold_period"`
+	RetiredRecords types.List   `tfsdk:"retired_records"`
+}
+
+type DNSSECRetiredDSModel struct {
+	KeyTag      types.String `tfsdk:"key_tag"`
+	Algorithm   types.Int64  `tfsdk:"algorithm"`
+	DigestType  types.Int64  `tfsdk:"digest_type"`
+	Digest      types.String `tfsdk:"digest"`
+	RemoveAfter types.String `tfsdk:"remove_after"`
+}
+
+// dsRecord is a DS record as submitted to the registry.
+type dsRecord struct {
+	// ID identifies the record at the registry. It is only set for published
+	// records, as key tags are not unique.
+	ID         string
+	KeyTag     string
+	Algorithm  int64
+	DigestType int64
+	Digest     string
+}
+
+// retiredDSRecord is a DS record removed from the configuration that is kept
+// published until RemoveAfter.
+type retiredDSRecord struct {
+	dsRecord
+	RemoveAfter time.Time
+}
+
+// dsSetChanges describes the API calls needed to reconcile the DS records of a domain.
+type dsSetChanges struct {
+	// Replace are published records whose key tag is reused by a desired
+	// record with different contents. As the registry stores a single record
+	// per key tag, they must be deleted before the new record is created.
+	Replace []dsRecord
+	// Create are the desired records that are not yet published.
+	Create []dsRecord
+	// Remove are the records to delete after all desired records are published.
+	Remove []dsRecord
+	// Held are the records no longer desired that remain published until their hold period ends.
+	Held []retiredDSRecord
+}
+
+func (r *DNSSECDSSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_ds_set"
+}
+
+func (r *DNSSECDSSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the complete set of DS records of a domain registered with Porkbun, for example to roll over a key signing key. " +
+			"New records are published before records that were removed from the configuration are deleted, so the domain keeps validating during the rollover. " +
+			"DS records not listed in `ds_records` are deleted, while records submitted with key data only are left untouched. " +
+			"Destroying the resource leaves the DS records published unless `allow_empty` is true. " +
+			"Do not combine this resource with `porkbun_dnssec_record` for the same domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to manage the DS records of (e.g., example.com).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ds_records": schema.SetNestedAttribute{
+				MarkdownDescription: "The DS records to publish at the registry. The registry stores a single record per key tag, so a record whose key tag is reused with different contents is replaced instead of being published alongside.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key_tag": schema.StringAttribute{
+							MarkdownDescription: "Key tag (key ID) calculated from the public key. Provided as a decimal string.",
+							Required:            true,
+						},
+						"algorithm": schema.Int64Attribute{
+							MarkdownDescription: "DNSSEC algorithm identifier, as defined in RFC 8624.",
+							Required:            true,
+							Validators: []validator.Int64{
+								enumvalidator.Valid(
+									porkbun.DnssecAlgorithmRsaMd5,
+									porkbun.DnssecAlgorithmDsaSha1,
+									porkbun.DnssecAlgorithmRsaSha1,
+									porkbun.DnssecAlgorithmDsaNsec3Sha1,
+									porkbun.DnssecAlgorithmRsaSha256,
+									porkbun.DnssecAlgorithmRsaSha512,
+									porkbun.DnssecAlgorithmGostR34111994,
+									porkbun.DnssecAlgorithmEcdsaSha256,
+									porkbun.DnssecAlgorithmEcdsaSha384,
+									porkbun.DnssecAlgorithmEd25519,
+									porkbun.DnssecAlgorithmEd448,
+								),
+							},
+						},
+						"digest_type": schema.Int64Attribute{
+							MarkdownDescription: "Hash algorithm identifier used to create the DS digest.",
+							Required:            true,
+							Validators: []validator.Int64{
+								enumvalidator.Valid(
+									porkbun.DnssecDigestTypeSha1,
+									porkbun.DnssecDigestTypeSha256,
+									porkbun.DnssecDigestTypeGostR34111994,
+									porkbun.DnssecDigestTypeSha384,
+								),
+							},
+						},
+						"digest": schema.StringAttribute{
+							MarkdownDescription: "Digest (hash) of the DNSKEY record, encoded in hexadecimal.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"allow_empty": schema.BoolAttribute{
+				MarkdownDescription: "Allow `ds_records` to be empty, which deletes all DS records and disables DNSSEC validation for the domain. " +
+					"Destroying the resource also deletes the DS records only if this is true; otherwise they are left published. Defaults to false.",
+				Optional: true,
+			},
+			"hold_period": schema.Int64Attribute{
+				MarkdownDescription: "Time to keep DS records that were removed from `ds_records` published before deleting them, in seconds, for example the TTL of the DS records at the parent zone. " +
+					"Records whose hold period has ended are deleted by the next apply. Defaults to 0, deleting them immediately after the new records were published.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retired_records": schema.ListAttribute{
+				MarkdownDescription: "DS records removed from `ds_records` that remain published until `remove_after`, an RFC 3339 timestamp.",
+				Computed:            true,
+				ElementType: types.ObjectType{
+					AttrTypes: dnssecRetiredDSObjectAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (r *DNSSECDSSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *DNSSECDSSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSSECDSSetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.DSRecords.IsUnknown() || data.DSRecords.IsNull() {
+		return
+	}
+
+	var records []DNSSECDSDataModel
+	resp.Diagnostics.Append(data.DSRecords.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(records) == 0 && !data.AllowEmpty.IsUnknown() && !data.AllowEmpty.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ds_records"),
+			"Empty DS Record Set",
+			"Removing all DS records disables DNSSEC validation for the domain. Set allow_empty to true to apply an empty set.",
+		)
+	}
+
+	seen := map[string]bool{}
+	for _, record := range records {
+		if record.KeyTag.IsUnknown() {
+			continue
+		}
+		if keyTag := record.KeyTag.ValueString(); seen[keyTag] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ds_records"),
+				"Duplicate Key Tag",
+				fmt.Sprintf("The registry stores a single DS record per key tag, but key tag %s is used more than once.", keyTag),
+			)
+		} else {
+			seen[keyTag] = true
+		}
+	}
+}
+
+func (r *DNSSECDSSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var state DNSSECDSSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Plan an update once the hold period of a retired record has ended, so
+	// that the next apply deletes it even if the configuration is unchanged.
+	retired := retiredDSRecordsFromList(ctx, state.RetiredRecords, &resp.Diagnostics)
+	now := time.Now()
+	if slices.ContainsFunc(retired, func(record retiredDSRecord) bool { return !now.Before(record.RemoveAfter) }) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("retired_records"), types.ListUnknown(types.ObjectType{AttrTypes: dnssecRetiredDSObjectAttrs}))...)
+	}
+}
+
+func (r *DNSSECDSSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSSECDSSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, &data, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECDSSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSSECDSSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	published, err := r.readDSRecords(ctx, data.Domain.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading DS Records", err.Error())
+		return
+	}
+
+	// Retired records that are still published remain retired, all other
+	// published records make up the set.
+	var held []retiredDSRecord
+	for _, record := range retiredDSRecordsFromList(ctx, data.RetiredRecords, &resp.Diagnostics) {
+		if slices.ContainsFunc(published, record.equal) {
+			held = append(held, record)
+		}
+	}
+	var current []dsRecord
+	for _, record := range published {
+		if !slices.ContainsFunc(held, func(h retiredDSRecord) bool { return h.equal(record) }) {
+			current = append(current, record)
+		}
+	}
+
+	// Keep the configured notation of equivalent records, e.g. lower-case digests.
+	var configured []dsRecord
+	if !data.DSRecords.IsNull() {
+		configured = dsRecordsFromSet(ctx, data.DSRecords, &resp.Diagnostics)
+	}
+	for i, record := range current {
+		if j := slices.IndexFunc(configured, record.equal); j >= 0 {
+			current[i] = configured[j]
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.Domain
+	data.DSRecords = dsRecordsToSet(current)
+	data.RetiredRecords = retiredDSRecordsToList(held)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECDSSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSSECDSSetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retired := retiredDSRecordsFromList(ctx, state.RetiredRecords, &resp.Diagnostics)
+	r.apply(ctx, &data, retired, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECDSSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSSECDSSetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := dsRecordsFromSet(ctx, data.DSRecords, &resp.Diagnostics)
+	for _, record := range retiredDSRecordsFromList(ctx, data.RetiredRecords, &resp.Diagnostics) {
+		records = append(records, record.dsRecord)
+	}
+
+	// Deleting all DS records disables DNSSEC validation for the domain, so
+	// they are only deleted if an empty set is allowed.
+	if !data.AllowEmpty.ValueBool() {
+		if len(records) > 0 {
+			keyTags := make([]string, 0, len(records))
+			for _, record := range records {
+				keyTags = append(keyTags, record.KeyTag)
+			}
+			resp.Diagnostics.AddWarning("DS Records Left in Place",
+				fmt.Sprintf("The DS records of %s with key tags %s were left published at the registry, as deleting them would disable DNSSEC validation for the domain. "+
+					"Set allow_empty to true before destroying the resource to delete them.", data.Domain.ValueString(), strings.Join(keyTags, ", ")))
+		}
+		return
+	}
+
+	published, err := r.readDSRecords(ctx, data.Domain.ValueString(), &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting DS Records", err.Error())
+		return
+	}
+
+	for _, record := range published {
+		if !slices.ContainsFunc(records, record.equal) {
+			continue
+		}
+		if _, err := r.client.Dns.DeleteDnssecRecord(ctx, data.Domain.ValueString(), record.ID); err != nil {
+			resp.Diagnostics.AddError("Error Deleting DS Records", fmt.Sprintf("failed deleting DS record with key tag %s: %s", record.KeyTag, err))
+		}
+	}
+}
+
+func (r *DNSSECDSSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.Set(ctx, &DNSSECDSSetResourceModel{
+		ID:             types.StringValue(req.ID),
+		Domain:         types.StringValue(req.ID),
+		DSRecords:      types.SetNull(types.ObjectType{AttrTypes: dnssecDSAttrTypes}),
+		AllowEmpty:     types.BoolNull(),
+		HoldPeriod:     types.Int64Null(),
+		RetiredRecords: types.ListNull(types.ObjectType{AttrTypes: dnssecRetiredDSObjectAttrs}),
+	})...)
+}
+
+// apply publishes the DS records of the plan, deleting records that are no
+// longer desired after all new records were published, and updates the
+// computed attributes of data.
+func (r *DNSSECDSSetResource) apply(ctx context.Context, data *DNSSECDSSetResourceModel, retired []retiredDSRecord, diagnostics *diag.Diagnostics) {
+	domain := data.Domain.ValueString()
+	desired := dsRecordsFromSet(ctx, data.DSRecords, diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	// The set may not have been known during validation, so check again before applying.
+	if len(desired) == 0 && !data.AllowEmpty.ValueBool() {
+		diagnostics.AddAttributeError(
+			path.Root("ds_records"),
+			"Empty DS Record Set",
+			"Removing all DS records disables DNSSEC validation for the domain. Set allow_empty to true to apply an empty set.",
+		)
+		return
+	}
+
+	published, err := r.readDSRecords(ctx, domain, diagnostics)
+	if err != nil {
+		diagnostics.AddError("Error Reading DS Records", err.Error())
+		return
+	}
+
+	hold := time.Duration(data.HoldPeriod.ValueInt64()) * time.Second
+	changes := planDSSetChanges(published, desired, retired, hold, time.Now())
+
+	for _, record := range changes.Replace {
+		if _, err := r.client.Dns.DeleteDnssecRecord(ctx, domain, record.ID); err != nil {
+			diagnostics.AddError("Error Replacing DS Record", fmt.Sprintf("failed deleting DS record with key tag %s: %s", record.KeyTag, err))
+			return
+		}
+	}
+	for _, record := range changes.Create {
+		if _, err := r.client.Dns.CreateDnssecRecord(ctx, domain, record.data()); err != nil {
+			diagnostics.AddError("Error Creating DS Record", fmt.Sprintf("failed creating DS record with key tag %s: %s", record.KeyTag, err))
+			return
+		}
+	}
+	for _, record := range changes.Remove {
+		if _, err := r.client.Dns.DeleteDnssecRecord(ctx, domain, record.ID); err != nil {
+			diagnostics.AddError("Error Deleting DS Record", fmt.Sprintf("failed deleting DS record with key tag %s: %s", record.KeyTag, err))
+			return
+		}
+	}
+
+	data.ID = data.Domain
+	data.RetiredRecords = retiredDSRecordsToList(changes.Held)
+}
+
+// readDSRecords returns the DS records published for the domain. Records
+// submitted with key data only are skipped.
+func (r *DNSSECDSSetResource) readDSRecords(ctx context.Context, domain string, diagnostics *diag.Diagnostics) ([]dsRecord, error) {
+	resp, err := r.client.Dns.GetDnssecRecords(ctx, domain)
+	if err != nil {
+		return nil, fmt.Errorf("error getting DNSSEC records: %w", err)
+	}
+
+	var records []dsRecord
+	for id, data := range resp.Records {
+		ds := dnssecDSDataFromRecord(id, &data, diagnostics)
+		if ds == nil {
+			continue
+		}
+		record := dsRecordFromModel(*ds)
+		record.ID = id
+		records = append(records, record)
+	}
+	slices.SortFunc(records, func(a, b dsRecord) int {
+		return cmp.Or(strings.Compare(a.KeyTag, b.KeyTag), strings.Compare(a.ID, b.ID))
+	})
+	return records, nil
+}
+
+// planDSSetChanges computes the changes needed to publish the desired records.
+// Published records that are not desired are retired for the hold period,
+// keeping the end of the hold period of records that were already retired.
+func planDSSetChanges(published, desired []dsRecord, retired []retiredDSRecord, hold time.Duration, now time.Time) dsSetChanges {
+	var changes dsSetChanges
+
+	for _, record := range desired {
+		if slices.ContainsFunc(published, record.equal) {
+			continue
+		}
+		changes.Create = append(changes.Create, record)
+		i := slices.IndexFunc(published, func(p dsRecord) bool {
+			return p.KeyTag == record.KeyTag && !slices.ContainsFunc(desired, p.equal) && !slices.ContainsFunc(changes.Replace, p.equal)
+		})
+		if i >= 0 {
+			changes.Replace = append(changes.Replace, published[i])
+		}
+	}
+
+	for _, record := range published {
+		if slices.ContainsFunc(desired, record.equal) || slices.ContainsFunc(changes.Replace, record.equal) {
+			continue
+		}
+
+		removeAfter := now.Add(hold)
+		if i := slices.IndexFunc(retired, func(r retiredDSRecord) bool { return r.equal(record) }); i >= 0 {
+			removeAfter = retired[i].RemoveAfter
+		}
+
+		if now.Before(removeAfter) {
+			changes.Held = append(changes.Held, retiredDSRecord{dsRecord: record, RemoveAfter: removeAfter})
+		} else {
+			changes.Remove = append(changes.Remove, record)
+		}
+	}
+
+	return changes
+}
+
+// equal reports whether both records are the same, ignoring the case of the
+// digest and the ID.
+func (d dsRecord) equal(other dsRecord) bool {
+	return d.KeyTag == other.KeyTag && d.Algorithm == other.Algorithm && d.DigestType == other.DigestType &&
+		strings.EqualFold(d.Digest, other.Digest)
+}
+
+// data returns the record in the form expected by the Porkbun API.
+func (d dsRecord) data() *porkbun.DnssecRecordData {
+	return &porkbun.DnssecRecordData{
+		KeyTag:     d.KeyTag,
+		Alg:        porkbun.DnssecAlgorithm(strconv.FormatInt(d.Algorithm, 10)),
+		DigestType: porkbun.DnssecDigestType(strconv.FormatInt(d.DigestType, 10)),
+		Digest:     d.Digest,
+	}
+}
+
+func dsRecordFromModel(m DNSSECDSDataModel) dsRecord {
+	return dsRecord{
+		KeyTag:     m.KeyTag.ValueString(),
+		Algorithm:  m.Algorithm.ValueInt64(),
+		DigestType: m.DigestType.ValueInt64(),
+		Digest:     m.Digest.ValueString(),
+	}
+}
+
+// dsRecordsFromSet converts the ds_records attribute to a slice of dsRecord.
+func dsRecordsFromSet(ctx context.Context, set types.Set, diagnostics *diag.Diagnostics) []dsRecord {
+	var models []DNSSECDSDataModel
+	diagnostics.Append(set.ElementsAs(ctx, &models, false)...)
+
+	records := make([]dsRecord, 0, len(models))
+	for _, m := range models {
+		records = append(records, dsRecordFromModel(m))
+	}
+	return records
+}
+
+// dsRecordsToSet converts a slice of dsRecord to the ds_records attribute.
+func dsRecordsToSet(records []dsRecord) types.Set {
+	return util.MustMapToSet(records, types.ObjectType{AttrTypes: dnssecDSAttrTypes}, func(record dsRecord) attr.Value {
+		return types.ObjectValueMust(dnssecDSAttrTypes, map[string]attr.Value{
+			"key_tag":     types.StringValue(record.KeyTag),
+			"algorithm":   types.Int64Value(record.Algorithm),
+			"digest_type": types.Int64Value(record.DigestType),
+			"digest":      types.StringValue(record.Digest),
+		})
+	})
+}
+
+// retiredDSRecordsFromList converts the retired_records attribute to a slice
+// of retiredDSRecord. Null and unknown lists result in an empty slice.
+func retiredDSRecordsFromList(ctx context.Context, list types.List, diagnostics *diag.Diagnostics) []retiredDSRecord {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+
+	var models []DNSSECRetiredDSModel
+	diagnostics.Append(list.ElementsAs(ctx, &models, false)...)
+
+	records := make([]retiredDSRecord, 0, len(models))
+	for _, m := range models {
+		removeAfter, err := time.Parse(time.RFC3339, m.RemoveAfter.ValueString())
+		if err != nil {
+			diagnostics.AddError("Invalid Retired DS Record", fmt.Sprintf("Invalid remove_after of key tag %s: %s", m.KeyTag.ValueString(), err))
+			continue
+		}
+		records = append(records, retiredDSRecord{
+			dsRecord: dsRecordFromModel(DNSSECDSDataModel{
+				KeyTag:     m.KeyTag,
+				Algorithm:  m.Algorithm,
+				DigestType: m.DigestType,
+				Digest:     m.Digest,
+			}),
+			RemoveAfter: removeAfter,
+		})
+	}
+	return records
+}
+
+// retiredDSRecordsToList converts a slice of retiredDSRecord to the retired_records attribute.
+func retiredDSRecordsToList(records []retiredDSRecord) types.List {
+	return util.MustMapToList(records, types.ObjectType{AttrTypes: dnssecRetiredDSObjectAttrs}, func(record retiredDSRecord) attr.Value {
+		return types.ObjectValueMust(dnssecRetiredDSObjectAttrs, map[string]attr.Value{
+			"key_tag":      types.StringValue(record.KeyTag),
+			"algorithm":    types.Int64Value(record.Algorithm),
+			"digest_type":  types.Int64Value(record.DigestType),
+			"digest":       types.StringValue(record.Digest),
+			"remove_after": types.StringValue(record.RemoveAfter.UTC().Format(time.RFC3339)),
+		})
+	})
+}
@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestAccDNSSECDSSetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDNSSECDSSetResourceConfig("64087", "15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_dnssec_ds_set.test",
+						tfjsonpath.New("ds_records"),
+						knownvalue.SetSizeExact(1),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "porkbun_dnssec_ds_set.test",
+				ImportState:                          true,
+				ImportStateId:                        testAccDomain(),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+			},
+		},
+	})
+}
+
+func testAccDNSSECDSSetResourceConfig(keyTag, digest string) string {
+	return fmt.Sprintf(`
+resource "porkbun_dnssec_ds_set" "test" {
+  domain = %q
+
+  ds_records = [{
+    key_tag     = %q
+    algorithm   = 13
+    digest_type = 2
+    digest      = %q
+  }]
+}
+`, testAccDomain(), keyTag, digest)
+}
+
+func TestPlanDSSetChanges(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldKey := dsRecord{KeyTag: "1", Algorithm: 13, DigestType: 2, Digest: "AA"}
+	newKey := dsRecord{KeyTag: "2", Algorithm: 13, DigestType: 2, Digest: "BB"}
+	reused := dsRecord{KeyTag: "1", Algorithm: 13, DigestType: 4, Digest: "CC"}
+	changed := dsRecord{KeyTag: "1", Algorithm: 13, DigestType: 2, Digest: "DD"}
+
+	tests := []struct {
+		name      string
+		published []dsRecord
+		desired   []dsRecord
+		retired   []retiredDSRecord
+		hold      time.Duration
+		want      dsSetChanges
+	}{
+		{
+			name:      "unchanged",
+			published: []dsRecord{oldKey},
+			desired:   []dsRecord{{KeyTag: "1", Algorithm: 13, DigestType: 2, Digest: "aa"}},
+		},
+		{
+			name:      "rollover",
+			published: []dsRecord{oldKey},
+			desired:   []dsRecord{newKey},
+			want:      dsSetChanges{Create: []dsRecord{newKey}, Remove: []dsRecord{oldKey}},
+		},
+		{
+			name:      "rollover with hold",
+			published: []dsRecord{oldKey},
+			desired:   []dsRecord{newKey},
+			hold:      time.Hour,
+			want:      dsSetChanges{Create: []dsRecord{newKey}, Held: []retiredDSRecord{{dsRecord: oldKey, RemoveAfter: now.Add(time.Hour)}}},
+		},
+		{
+			name:      "hold ongoing",
+			published: []dsRecord{oldKey, newKey},
+			desired:   []dsRecord{newKey},
+			retired:   []retiredDSRecord{{dsRecord: oldKey, RemoveAfter: now.Add(time.Minute)}},
+			hold:      time.Hour,
+			want:      dsSetChanges{Held: []retiredDSRecord{{dsRecord: oldKey, RemoveAfter: now.Add(time.Minute)}}},
+		},
+		{
+			name:      "hold ended",
+			published: []dsRecord{oldKey, newKey},
+			desired:   []dsRecord{newKey},
+			retired:   []retiredDSRecord{{dsRecord: oldKey, RemoveAfter: now}},
+			hold:      time.Hour,
+			want:      dsSetChanges{Remove: []dsRecord{oldKey}},
+		},
+		{
+			name:      "key tag reused",
+			published: []dsRecord{oldKey},
+			desired:   []dsRecord{reused},
+			hold:      time.Hour,
+			want:      dsSetChanges{Replace: []dsRecord{oldKey}, Create: []dsRecord{reused}},
+		},
+		{
+			name:      "key tag shared with a desired record",
+			published: []dsRecord{reused, oldKey},
+			desired:   []dsRecord{reused, changed},
+			hold:      time.Hour,
+			want:      dsSetChanges{Replace: []dsRecord{oldKey}, Create: []dsRecord{changed}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := planDSSetChanges(tt.published, tt.desired, tt.retired, tt.hold, now); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planDSSetChanges() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDNSSECDSSetResource_Lifecycle(t *testing.T) {
+	api := newFakeAPI()
+	api.dnssec["example.com"] = map[string]porkbun.DnssecRecordData{
+		"1": {KeyTag: "1", Alg: "13", DigestType: "2", Digest: "AA"},
+	}
+	r := &DNSSECDSSetResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	// Create takes over the published records and rolls over to the new key.
+	plan := DNSSECDSSetResourceModel{
+		ID:             types.StringUnknown(),
+		Domain:         types.StringValue("example.com"),
+		DSRecords:      dsRecordsToSet([]dsRecord{{KeyTag: "2", Algorithm: 13, DigestType: 2, Digest: "BB"}}),
+		AllowEmpty:     types.BoolNull(),
+		HoldPeriod:     types.Int64Value(3600),
+		RetiredRecords: types.ListUnknown(types.ObjectType{AttrTypes: dnssecRetiredDSObjectAttrs}),
+	}
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+	if got := slices.Sorted(maps.Keys(api.dnssec["example.com"])); !slices.Equal(got, []string{"1", "2"}) {
+		t.Fatalf("key tags after Create() = %v, want the old key held", got)
+	}
+
+	var state DNSSECDSSetResourceModel
+	createResp.State.Get(ctx, &state)
+	if n := len(state.RetiredRecords.Elements()); n != 1 {
+		t.Fatalf("retired_records has %d elements, want 1", n)
+	}
+
+	// Read keeps the held key out of ds_records.
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	readResp.State.Get(ctx, &state)
+	if !state.DSRecords.Equal(plan.DSRecords) {
+		t.Errorf("ds_records after Read() = %v, want %v", state.DSRecords, plan.DSRecords)
+	}
+
+	// Once the hold period ended, the next plan deletes the old key after the new one is published.
+	state.RetiredRecords = retiredDSRecordsToList([]retiredDSRecord{{
+		dsRecord:    dsRecord{KeyTag: "1", Algorithm: 13, DigestType: 2, Digest: "AA"},
+		RemoveAfter: time.Now().Add(-time.Minute),
+	}})
+	priorState := tfsdk.State{Schema: schemaResp.Schema}
+	priorState.Set(ctx, &state)
+
+	modifyResp := fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: priorState.Raw}}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: priorState, Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: priorState.Raw}}, &modifyResp)
+	var planned DNSSECDSSetResourceModel
+	modifyResp.Plan.Get(ctx, &planned)
+	if !planned.RetiredRecords.IsUnknown() {
+		t.Fatalf("retired_records after ModifyPlan() = %v, want unknown", planned.RetiredRecords)
+	}
+
+	api.calls = nil
+	updateResp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: modifyResp.Plan, State: priorState}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() unexpected error: %v", updateResp.Diagnostics)
+	}
+	if _, ok := api.dnssec["example.com"]["1"]; ok || len(api.dnssec["example.com"]) != 1 {
+		t.Errorf("records after Update() = %v, want only the new key", api.dnssec["example.com"])
+	}
+	if !slices.Equal(api.calls, []string{"dns/getDnssecRecords", "dns/deleteDnssecRecord"}) {
+		t.Errorf("calls during Update() = %v", api.calls)
+	}
+
+	// Without allow_empty, destroying the resource leaves the records published.
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: updateResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if deleteResp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Delete() diagnostics = %v, want a warning", deleteResp.Diagnostics)
+	}
+	if _, ok := api.dnssec["example.com"]["2"]; !ok {
+		t.Errorf("records after Delete() = %v, want the new key left in place", api.dnssec["example.com"])
+	}
+}
+
+func TestDNSSECDSSetResource_DeleteAllowEmpty(t *testing.T) {
+	api := newFakeAPI()
+	api.dnssec["example.com"] = map[string]porkbun.DnssecRecordData{
+		"1": {KeyTag: "1", Alg: "13", DigestType: "2", Digest: "AA"},
+		"2": {KeyTag: "2", Alg: "13", DigestType: "2", Digest: "BB"},
+		"3": {KeyTag: "3", Alg: "13", DigestType: "2", Digest: "CC"},
+	}
+	r := &DNSSECDSSetResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(ctx, &DNSSECDSSetResourceModel{
+		ID:         types.StringValue("example.com"),
+		Domain:     types.StringValue("example.com"),
+		DSRecords:  dsRecordsToSet([]dsRecord{{KeyTag: "2", Algorithm: 13, DigestType: 2, Digest: "BB"}}),
+		AllowEmpty: types.BoolValue(true),
+		HoldPeriod: types.Int64Null(),
+		RetiredRecords: retiredDSRecordsToList([]retiredDSRecord{{
+			dsRecord:    dsRecord{KeyTag: "1", Algorithm: 13, DigestType: 2, Digest: "AA"},
+			RemoveAfter: time.Now().Add(time.Hour),
+		}}),
+	})
+
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: state}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() || deleteResp.Diagnostics.WarningsCount() != 0 {
+		t.Fatalf("Delete() unexpected diagnostics: %v", deleteResp.Diagnostics)
+	}
+	if got := slices.Sorted(maps.Keys(api.dnssec["example.com"])); !slices.Equal(got, []string{"3"}) {
+		t.Errorf("key tags after Delete() = %v, want only the unmanaged record", got)
+	}
+}
+
+func TestDNSSECDSSetResource_SharedKeyTag(t *testing.T) {
+	// The registry identifies records by ID, as a key tag can be shared by
+	// several records, e.g. with different digest types.
+	api := newFakeAPI()
+	api.dnssec["example.com"] = map[string]porkbun.DnssecRecordData{
+		"101": {KeyTag: "1", Alg: "13", DigestType: "2", Digest: "AA"},
+		"102": {KeyTag: "1", Alg: "13", DigestType: "4", Digest: "CC"},
+	}
+	r := &DNSSECDSSetResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	kept := dsRecord{KeyTag: "1", Algorithm: 13, DigestType: 4, Digest: "CC"}
+	plan := DNSSECDSSetResourceModel{
+		ID:             types.StringUnknown(),
+		Domain:         types.StringValue("example.com"),
+		DSRecords:      dsRecordsToSet([]dsRecord{kept}),
+		AllowEmpty:     types.BoolValue(true),
+		HoldPeriod:     types.Int64Value(0),
+		RetiredRecords: types.ListUnknown(types.ObjectType{AttrTypes: dnssecRetiredDSObjectAttrs}),
+	}
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+	if got := slices.Sorted(maps.Keys(api.dnssec["example.com"])); !slices.Equal(got, []string{"102"}) {
+		t.Errorf("record IDs after Create() = %v, want only the desired record", got)
+	}
+
+	api.dnssec["example.com"]["103"] = porkbun.DnssecRecordData{KeyTag: "1", Alg: "13", DigestType: "2", Digest: "DD"}
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if got := slices.Sorted(maps.Keys(api.dnssec["example.com"])); !slices.Equal(got, []string{"103"}) {
+		t.Errorf("record IDs after Delete() = %v, want only the unmanaged record", got)
+	}
+}
+
+func TestDNSSECDSSetResource_AddBeforeRemove(t *testing.T) {
+	api := newFakeAPI()
+	api.dnssec["example.com"] = map[string]porkbun.DnssecRecordData{
+		"1": {KeyTag: "1", Alg: "13", DigestType: "2", Digest: "AA"},
+	}
+	r := &DNSSECDSSetResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := DNSSECDSSetResourceModel{
+		ID:             types.StringUnknown(),
+		Domain:         types.StringValue("example.com"),
+		DSRecords:      dsRecordsToSet([]dsRecord{{KeyTag: "2", Algorithm: 13, DigestType: 2, Digest: "BB"}}),
+		AllowEmpty:     types.BoolNull(),
+		HoldPeriod:     types.Int64Null(),
+		RetiredRecords: types.ListUnknown(types.ObjectType{AttrTypes: dnssecRetiredDSObjectAttrs}),
+	}
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+
+	want := []string{"dns/getDnssecRecords", "dns/createDnssecRecord", "dns/deleteDnssecRecord"}
+	if !slices.Equal(api.calls, want) {
+		t.Errorf("calls = %v, want %v", api.calls, want)
+	}
+
+	// An empty set is refused at apply time unless allowed.
+	plan.DSRecords = dsRecordsToSet(nil)
+	createReq.Plan.Set(ctx, &plan)
+	createResp = fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if !createResp.Diagnostics.HasError() {
+		t.Errorf("Create() with an empty set did not fail")
+	}
+}
+
+func TestDNSSECDSSetResource_ValidateConfig(t *testing.T) {
+	r := &DNSSECDSSetResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	record := dsRecord{KeyTag: "1", Algorithm: 13, DigestType: 2, Digest: "AA"}
+	tests := []struct {
+		name       string
+		records    []dsRecord
+		allowEmpty types.Bool
+		wantErr    bool
+	}{
+		{name: "valid", records: []dsRecord{record}, allowEmpty: types.BoolNull()},
+		{name: "empty", records: nil, allowEmpty: types.BoolNull(), wantErr: true},
+		{name: "empty allowed", records: nil, allowEmpty: types.BoolValue(true)},
+		{name: "duplicate key tag", records: []dsRecord{record, {KeyTag: "1", Algorithm: 13, DigestType: 4, Digest: "BB"}}, allowEmpty: types.BoolNull(), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			config.Set(ctx, &DNSSECDSSetResourceModel{
+				ID:             types.StringNull(),
+				Domain:         types.StringValue("example.com"),
+				DSRecords:      dsRecordsToSet(tt.records),
+				AllowEmpty:     tt.allowEmpty,
+				HoldPeriod:     types.Int64Null(),
+				RetiredRecords: types.ListNull(types.ObjectType{AttrTypes: dnssecRetiredDSObjectAttrs}),
+			})
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
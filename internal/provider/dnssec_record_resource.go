@@ -2,32 +2,46 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/tuzzmaniandevil/porkbun-go"
 
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnssec"
 	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
 	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/enumvalidator"
 )
 
 var (
-	_ resource.Resource                = &DNSSECRecordResource{}
-	_ resource.ResourceWithImportState = &DNSSECRecordResource{}
+	_ resource.Resource                   = &DNSSECRecordResource{}
+	_ resource.ResourceWithImportState    = &DNSSECRecordResource{}
+	_ resource.ResourceWithValidateConfig = &DNSSECRecordResource{}
+	_ resource.ResourceWithModifyPlan     = &DNSSECRecordResource{}
 )
 
+// dnskeyQueryTimeoutDefault is the default timeout of a single DNSKEY query, in seconds.
+const dnskeyQueryTimeoutDefault = 5
+
 // NewDNSSECRecordResource returns a new instance of the resource.
 func NewDNSSECRecordResource() resource.Resource {
 	return &DNSSECRecordResource{}
@@ -43,6 +57,8 @@ type DNSSECRecordResourceModel struct {
 	MaxSigLife types.Int64         `tfsdk:"max_sig_life"`
 	DSData     *DNSSECDSDataModel  `tfsdk:"ds_data"`
 	KeyData    *DNSSECKeyDataModel `tfsdk:"key_data"`
+
+	VerifyDNSKEY *DNSSECVerifyDNSKEYModel `tfsdk:"verify_dnskey"`
 }
 
 type DNSSECDSDataModel struct {
@@ -59,13 +75,19 @@ type DNSSECKeyDataModel struct {
 	PublicKey types.String `tfsdk:"public_key"`
 }
 
+type DNSSECVerifyDNSKEYModel struct {
+	Resolvers types.List  `tfsdk:"resolvers"`
+	Timeout   types.Int64 `tfsdk:"timeout"`
+}
+
 func (r *DNSSECRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dnssec_record"
 }
 
 func (r *DNSSECRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages DNSSEC settings (DS/DNSKEY) for a domain registered with Porkbun.",
+		MarkdownDescription: "Manages DNSSEC settings (DS/DNSKEY) for a domain registered with Porkbun. " +
+			"Changes made outside of Terraform to any field returned by the Porkbun API are detected and cause the record to be replaced.",
 		Attributes: map[string]schema.Attribute{
 			"domain": schema.StringAttribute{
 				Required:            true,
@@ -139,7 +161,7 @@ func (r *DNSSECRecordResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"key_data": schema.SingleNestedAttribute{
-				MarkdownDescription: "DNSKEY record data. Some registries accept `key_data` instead of, or in addition to, `ds_data`. If DS records are rejected, try creating DNSSEC with `key_data` only. **At least one of `ds_data` or `key_data` must be supplied.**",
+				MarkdownDescription: "DNSKEY record data. Some registries accept `key_data` instead of, or in addition to, `ds_data`. If DS records are rejected, try creating DNSSEC with `key_data` only, in which case the record is identified by the key tag computed from the key. **At least one of `ds_data` or `key_data` must be supplied.**",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"flags": schema.Int64Attribute{
@@ -187,6 +209,31 @@ func (r *DNSSECRecordResource) Schema(ctx context.Context, req resource.SchemaRe
 					objectplanmodifier.RequiresReplace(),
 				},
 			},
+			"verify_dnskey": schema.SingleNestedAttribute{
+				MarkdownDescription: "When set, the DNSKEY records of the domain are queried at plan time, and the plan fails if `ds_data`, or `key_data` if no DS data is given, matches none of them. " +
+					"This prevents publishing a DS record for a key that is not served by the zone yet, which breaks validation of the domain.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"resolvers": schema.ListAttribute{
+						MarkdownDescription: "Addresses of the DNS servers to query, as `host` or `host:port`, for example a recursive resolver or the authoritative nameservers of the domain. They are tried in turn until one answers.",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+						},
+					},
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: fmt.Sprintf("Timeout of a single DNS query, in seconds. Defaults to %d.", dnskeyQueryTimeoutDefault),
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(dnskeyQueryTimeoutDefault),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -195,6 +242,48 @@ func (r *DNSSECRecordResource) Configure(ctx context.Context, req resource.Confi
 	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
 }
 
+func (r *DNSSECRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var domain types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	ds, key := getDNSSECBlocks(ctx, req.Config.GetAttribute, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if ds != nil {
+		validateDNSSECDSData(ds, &resp.Diagnostics)
+	}
+	if key != nil {
+		validateDNSSECKeyData(key, &resp.Diagnostics)
+	}
+	if ds != nil && key != nil && !resp.Diagnostics.HasError() {
+		checkDSMatchesKey(domain, ds, key, &resp.Diagnostics)
+	}
+}
+
+func (r *DNSSECRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || (!req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw)) {
+		return
+	}
+
+	var domain types.String
+	var verify types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("verify_dnskey"), &verify)...)
+	ds, key := getDNSSECBlocks(ctx, req.Plan.GetAttribute, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || verify.IsNull() || verify.IsUnknown() || domain.IsUnknown() {
+		return
+	}
+
+	var settings DNSSECVerifyDNSKEYModel
+	resp.Diagnostics.Append(verify.As(ctx, &settings, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || settings.Resolvers.IsUnknown() || settings.Timeout.IsUnknown() {
+		return
+	}
+
+	verifyLiveDNSKEY(ctx, domain.ValueString(), ds, key, &settings, &resp.Diagnostics)
+}
+
 func (r *DNSSECRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data DNSSECRecordResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -238,9 +327,13 @@ func (r *DNSSECRecordResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	// FIXME: Some TLDs allow DNSSEC without ds_data and thus key_tag. Figure
-	//        out how the Porkbun API handles this and update the code accordingly.
-	dnssecRecord, ok, err := r.readDNSSECRecord(ctx, data.Domain.ValueString(), data.DSData.KeyTag.ValueString())
+	keyTag, err := data.keyTag()
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading DNSSEC", err.Error())
+		return
+	}
+
+	id, dnssecRecord, ok, err := r.readDNSSECRecord(ctx, data.Domain.ValueString(), keyTag, data.KeyData)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading DNSSEC", err.Error())
 		return
@@ -250,30 +343,22 @@ func (r *DNSSECRecordResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	if dnssecRecord.Alg != "" {
-		data.DSData = &DNSSECDSDataModel{
-			KeyTag:     types.StringValue(dnssecRecord.KeyTag),
-			Algorithm:  util.Int64Value(string(dnssecRecord.Alg), &resp.Diagnostics),
-			DigestType: util.Int64Value(string(dnssecRecord.DigestType), &resp.Diagnostics),
-			Digest:     types.StringValue(dnssecRecord.Digest),
+	// Only blocks in the state are refreshed, as registries may return the
+	// block that was not used to enable DNSSEC. A block in the state that is
+	// no longer returned is removed so the drift shows in the plan.
+	if data.DSData != nil {
+		ds := dnssecDSDataFromRecord(id, dnssecRecord, &resp.Diagnostics)
+		if ds != nil && strings.EqualFold(ds.Digest.ValueString(), data.DSData.Digest.ValueString()) {
+			ds.Digest = data.DSData.Digest
 		}
+		data.DSData = ds
 	}
-
-	if dnssecRecord.KeyDataFlags != nil {
-		var algorithm types.Int64
-		if dnssecRecord.KeyDataAlgo != nil {
-			algo := string(*dnssecRecord.KeyDataAlgo)
-			algorithm = util.Int64Value(algo, &resp.Diagnostics)
-		} else {
-			algorithm = types.Int64Null()
-		}
-
-		data.KeyData = &DNSSECKeyDataModel{
-			Flags:     util.Int64PointerValue(dnssecRecord.KeyDataFlags, &resp.Diagnostics),
-			Protocol:  util.Int64PointerValue(dnssecRecord.KeyDataProtocol, &resp.Diagnostics),
-			Algorithm: algorithm,
-			PublicKey: types.StringPointerValue(dnssecRecord.KeyDataPubKey),
+	if data.KeyData != nil {
+		key := dnssecKeyDataFromRecord(dnssecRecord, &resp.Diagnostics)
+		if key != nil && samePublicKey(key.PublicKey.ValueString(), data.KeyData.PublicKey.ValueString()) {
+			key.PublicKey = data.KeyData.PublicKey
 		}
+		data.KeyData = key
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -302,39 +387,164 @@ func (r *DNSSECRecordResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	if _, err := r.client.Dns.DeleteDnssecRecord(ctx, data.Domain.ValueString(), data.DSData.KeyTag.ValueString()); err != nil {
+	keyTag, err := data.keyTag()
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting DNSSEC", err.Error())
+		return
+	}
+
+	id, _, ok, err := r.readDNSSECRecord(ctx, data.Domain.ValueString(), keyTag, data.KeyData)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting DNSSEC", err.Error())
+		return
+	}
+	if !ok {
+		return
+	}
+
+	if _, err := r.client.Dns.DeleteDnssecRecord(ctx, data.Domain.ValueString(), id); err != nil {
 		resp.Diagnostics.AddError("Error Deleting DNSSEC", err.Error())
 		return
 	}
 }
 
 func (r *DNSSECRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.SplitN(req.ID, ":", 2)
-
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	domain, keyTag, ok := strings.Cut(req.ID, ":")
+	if !ok || domain == "" || keyTag == "" {
 		resp.Diagnostics.AddError("Invalid Import ID", "Expected format: <domain>:<key_tag>")
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &DNSSECRecordResourceModel{
-		Domain: types.StringValue(idParts[0]),
-		DSData: &DNSSECDSDataModel{
-			KeyTag: types.StringValue(idParts[1]),
-		},
-	})...)
+	id, dnssecRecord, ok, err := r.readDNSSECRecord(ctx, domain, keyTag, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing DNSSEC", err.Error())
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError("Error Importing DNSSEC", fmt.Sprintf("No DNSSEC record with key tag %s found for domain %s.", keyTag, domain))
+		return
+	}
+
+	data := DNSSECRecordResourceModel{
+		Domain:     types.StringValue(domain),
+		MaxSigLife: types.Int64Null(),
+		DSData:     dnssecDSDataFromRecord(id, dnssecRecord, &resp.Diagnostics),
+		KeyData:    dnssecKeyDataFromRecord(dnssecRecord, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// keyTag returns the key tag identifying the record, taken from ds_data or
+// computed from key_data.
+func (m *DNSSECRecordResourceModel) keyTag() (string, error) {
+	switch {
+	case m.DSData != nil && m.DSData.KeyTag.ValueString() != "":
+		return m.DSData.KeyTag.ValueString(), nil
+	case m.KeyData != nil:
+		return strconv.FormatUint(uint64(m.KeyData.key().KeyTag()), 10), nil
+	default:
+		return "", errors.New("either ds_data or key_data must be set to identify the DNSSEC record")
+	}
+}
+
+// key returns the DNSKEY described by the model.
+func (m *DNSSECKeyDataModel) key() dnssec.Key {
+	return dnssec.Key{
+		Flags:     uint16(m.Flags.ValueInt64()),
+		Protocol:  uint8(m.Protocol.ValueInt64()),
+		Algorithm: uint8(m.Algorithm.ValueInt64()),
+		PublicKey: strings.Join(strings.Fields(m.PublicKey.ValueString()), ""),
+	}
 }
 
-// readDNSSECRecord retrieves the DNSSEC record for the specified domain and key tag.
-func (r *DNSSECRecordResource) readDNSSECRecord(ctx context.Context, domain, keyTag string) (*porkbun.DnssecRecordData, bool, error) {
+// readDNSSECRecord retrieves the DNSSEC record of the domain with the given key
+// tag, or whose public key matches key if it is not nil. It returns the ID the
+// record is stored under, which is needed to delete it.
+func (r *DNSSECRecordResource) readDNSSECRecord(ctx context.Context, domain, keyTag string, key *DNSSECKeyDataModel) (string, *porkbun.DnssecRecordData, bool, error) {
 	resp, err := r.client.Dns.GetDnssecRecords(ctx, domain)
 	if err != nil {
-		return nil, false, fmt.Errorf("error getting DNSSEC records: %w", err)
+		return "", nil, false, fmt.Errorf("error getting DNSSEC records: %w", err)
 	}
 
-	dnssecRecord, ok := resp.Records[keyTag]
+	id, ok := findDNSSECRecord(resp.Records, keyTag, key)
 	if !ok {
-		return nil, false, nil
+		return "", nil, false, nil
 	}
 
-	return &dnssecRecord, true, nil
+	dnssecRecord := resp.Records[id]
+	return id, &dnssecRecord, true, nil
+}
+
+// findDNSSECRecord returns the ID of the record with the given key tag, or
+// whose public key matches key if it is not nil.
+func findDNSSECRecord(records map[string]porkbun.DnssecRecordData, keyTag string, key *DNSSECKeyDataModel) (string, bool) {
+	if _, ok := records[keyTag]; ok {
+		return keyTag, true
+	}
+
+	ids := slices.Sorted(maps.Keys(records))
+	for _, id := range ids {
+		if records[id].KeyTag == keyTag {
+			return id, true
+		}
+	}
+	if key != nil {
+		for _, id := range ids {
+			if pubKey := records[id].KeyDataPubKey; pubKey != nil && samePublicKey(*pubKey, key.PublicKey.ValueString()) {
+				return id, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// dnssecDSDataFromRecord returns the DS data of the record, or nil if the
+// record has none.
+func dnssecDSDataFromRecord(id string, dnssecRecord *porkbun.DnssecRecordData, diagnostics *diag.Diagnostics) *DNSSECDSDataModel {
+	if dnssecRecord.Alg == "" && dnssecRecord.Digest == "" {
+		return nil
+	}
+
+	keyTag := dnssecRecord.KeyTag
+	if keyTag == "" {
+		keyTag = id
+	}
+
+	return &DNSSECDSDataModel{
+		KeyTag:     types.StringValue(keyTag),
+		Algorithm:  util.Int64Value(string(dnssecRecord.Alg), diagnostics),
+		DigestType: util.Int64Value(string(dnssecRecord.DigestType), diagnostics),
+		Digest:     types.StringValue(dnssecRecord.Digest),
+	}
+}
+
+// dnssecKeyDataFromRecord returns the key data of the record, or nil if the
+// record has none.
+func dnssecKeyDataFromRecord(dnssecRecord *porkbun.DnssecRecordData, diagnostics *diag.Diagnostics) *DNSSECKeyDataModel {
+	if dnssecRecord.KeyDataFlags == nil && dnssecRecord.KeyDataPubKey == nil {
+		return nil
+	}
+
+	algorithm := types.Int64Null()
+	if dnssecRecord.KeyDataAlgo != nil {
+		algorithm = util.Int64Value(string(*dnssecRecord.KeyDataAlgo), diagnostics)
+	}
+
+	return &DNSSECKeyDataModel{
+		Flags:     util.Int64PointerValue(dnssecRecord.KeyDataFlags, diagnostics),
+		Protocol:  util.Int64PointerValue(dnssecRecord.KeyDataProtocol, diagnostics),
+		Algorithm: algorithm,
+		PublicKey: types.StringPointerValue(dnssecRecord.KeyDataPubKey),
+	}
+}
+
+// samePublicKey reports whether both base64-encoded public keys are equal,
+// ignoring whitespace.
+func samePublicKey(a, b string) bool {
+	return strings.Join(strings.Fields(a), "") == strings.Join(strings.Fields(b), "")
 }
@@ -1,14 +1,24 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/miekg/dns"
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver/resolvertest"
 )
 
 func TestAccDNSSECRecordResource(t *testing.T) {
@@ -128,3 +138,307 @@ resource "porkbun_dnssec_record" "test" {
 }
 `, testAccDomain(), maxSigLife, keyTag, algorithm, digestType, digest)
 }
+
+// testDNSSECPublicKey is the public key of the ECDSA P-384 example in RFC 6605, with key tag 10771.
+const testDNSSECPublicKey = "xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8/uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40"
+
+func TestDNSSECRecordResource_KeyDataOnly(t *testing.T) {
+	api := newFakeAPI()
+	r := &DNSSECRecordResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := DNSSECRecordResourceModel{
+		Domain:     types.StringValue("example.com"),
+		MaxSigLife: types.Int64Null(),
+		KeyData: &DNSSECKeyDataModel{
+			Flags:     types.Int64Value(257),
+			Protocol:  types.Int64Value(3),
+			Algorithm: types.Int64Value(14),
+			PublicKey: types.StringValue(testDNSSECPublicKey),
+		},
+	}
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+	if _, ok := api.dnssec["example.com"]["10771"]; !ok {
+		t.Fatalf("records after Create() = %v, want key tag 10771", api.dnssec["example.com"])
+	}
+
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() unexpected error: %v", readResp.Diagnostics)
+	}
+	var state DNSSECRecordResourceModel
+	readResp.State.Get(ctx, &state)
+	if state.DSData != nil || state.KeyData == nil || state.KeyData.Flags.ValueInt64() != 257 {
+		t.Fatalf("Read() = %+v, want unchanged key_data only", state)
+	}
+
+	// Changes outside of Terraform are detected.
+	record := api.dnssec["example.com"]["10771"]
+	flags := "256"
+	record.KeyDataFlags = &flags
+	api.dnssec["example.com"]["10771"] = record
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	readResp.State.Get(ctx, &state)
+	if state.KeyData.Flags.ValueInt64() != 256 {
+		t.Errorf("key_data.flags after Read() = %v, want 256", state.KeyData.Flags)
+	}
+
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if len(api.dnssec["example.com"]) != 0 {
+		t.Errorf("records after Delete() = %v, want none", api.dnssec["example.com"])
+	}
+}
+
+func TestDNSSECRecordResource_Read(t *testing.T) {
+	api := newFakeAPI()
+	r := &DNSSECRecordResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	flags, protocol, algorithm, publicKey := "257", "3", porkbun.DnssecAlgorithmEcdsaSha256, testDNSSECPublicKey
+	api.dnssec["example.com"] = map[string]porkbun.DnssecRecordData{
+		"64087": {KeyTag: "64087", Alg: "13", DigestType: "2", Digest: "15e445bd08128bdc213e25f1c8227df4cb35186cac701c1c335b2c406d5530dc"},
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(ctx, &DNSSECRecordResourceModel{
+		Domain:     types.StringValue("example.com"),
+		MaxSigLife: types.Int64Value(86400),
+		DSData: &DNSSECDSDataModel{
+			KeyTag:     types.StringValue("64087"),
+			Algorithm:  types.Int64Value(13),
+			DigestType: types.Int64Value(2),
+			Digest:     types.StringValue("15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC"),
+		},
+	})
+
+	readResp := fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &readResp)
+	var got DNSSECRecordResourceModel
+	readResp.State.Get(ctx, &got)
+	if got.DSData.Digest.ValueString() != "15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC" {
+		t.Errorf("digest after Read() = %v, want configured notation", got.DSData.Digest)
+	}
+
+	api.dnssec["example.com"]["64087"] = porkbun.DnssecRecordData{KeyTag: "64087", Alg: "8", DigestType: "4", Digest: "AB"}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &readResp)
+	readResp.State.Get(ctx, &got)
+	if got.DSData.Algorithm.ValueInt64() != 8 || got.DSData.DigestType.ValueInt64() != 4 || got.DSData.Digest.ValueString() != "AB" {
+		t.Errorf("ds_data after Read() = %+v, want the changed record", got.DSData)
+	}
+
+	api.dnssec["example.com"]["64087"] = porkbun.DnssecRecordData{KeyTag: "64087", KeyDataFlags: &flags, KeyDataProtocol: &protocol, KeyDataAlgo: &algorithm, KeyDataPubKey: &publicKey}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &readResp)
+	readResp.State.Get(ctx, &got)
+	if got.DSData != nil {
+		t.Errorf("ds_data after Read() = %+v, want null as the record has no DS data", got.DSData)
+	}
+	if got.KeyData != nil {
+		t.Errorf("key_data after Read() = %+v, want null as it is not in the state", got.KeyData)
+	}
+
+	delete(api.dnssec["example.com"], "64087")
+	r.Read(ctx, fwresource.ReadRequest{State: state}, &readResp)
+	if !readResp.State.Raw.IsNull() {
+		t.Errorf("Read() did not remove the deleted record from the state")
+	}
+}
+
+func TestDNSSECRecordResource_ImportState(t *testing.T) {
+	api := newFakeAPI()
+	r := &DNSSECRecordResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	flags, protocol, algorithm, publicKey := "257", "3", porkbun.DnssecAlgorithmEcdsaSha384, testDNSSECPublicKey
+	api.dnssec["example.com"] = map[string]porkbun.DnssecRecordData{
+		"10771": {
+			KeyTag: "10771", Alg: "14", DigestType: "4", Digest: "72D7B62976CE06438E9C0BF319013CF801F09ECC84B8D7E9495F27E305C6A9B0563A9B5F4D288405C3008A946DF983D6",
+			KeyDataFlags: &flags, KeyDataProtocol: &protocol, KeyDataAlgo: &algorithm, KeyDataPubKey: &publicKey,
+		},
+	}
+
+	resp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "example.com:10771"}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState() unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got DNSSECRecordResourceModel
+	resp.State.Get(ctx, &got)
+	if got.DSData == nil || got.DSData.KeyTag.ValueString() != "10771" || got.DSData.DigestType.ValueInt64() != 4 {
+		t.Errorf("ds_data after ImportState() = %+v, want the DS data of the record", got.DSData)
+	}
+	if got.KeyData == nil || got.KeyData.Flags.ValueInt64() != 257 || got.KeyData.Algorithm.ValueInt64() != 14 || got.KeyData.PublicKey.ValueString() != publicKey {
+		t.Errorf("key_data after ImportState() = %+v, want the key data of the record", got.KeyData)
+	}
+
+	resp = fwresource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "example.com:12345"}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Errorf("ImportState() of a missing record did not fail")
+	}
+}
+
+// testDNSSECDigest is the SHA-384 digest of testDNSSECPublicKey published at example.com.
+const testDNSSECDigest = "FF88CCDD780EFC5A07F904E7495C975EC5D44420EF70C5C615551FF7E9F940716B40489D0553C47BC779BB47C651ECEE"
+
+func TestDNSSECRecordResource_ValidateConfig(t *testing.T) {
+	r := &DNSSECRecordResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	dsData := func(keyTag string, algorithm, digestType int64, digest string) *DNSSECDSDataModel {
+		return &DNSSECDSDataModel{
+			KeyTag:     types.StringValue(keyTag),
+			Algorithm:  types.Int64Value(algorithm),
+			DigestType: types.Int64Value(digestType),
+			Digest:     types.StringValue(digest),
+		}
+	}
+	keyData := func(algorithm int64) *DNSSECKeyDataModel {
+		return &DNSSECKeyDataModel{
+			Flags:     types.Int64Value(257),
+			Protocol:  types.Int64Value(3),
+			Algorithm: types.Int64Value(algorithm),
+			PublicKey: types.StringValue(testDNSSECPublicKey),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ds       *DNSSECDSDataModel
+		key      *DNSSECKeyDataModel
+		wantErr  bool
+		wantWarn bool
+	}{
+		{name: "ds_data", ds: dsData("10771", 14, 4, testDNSSECDigest)},
+		{name: "lower-case digest", ds: dsData("10771", 14, 4, strings.ToLower(testDNSSECDigest))},
+		{name: "invalid key tag", ds: dsData("65536", 14, 4, testDNSSECDigest), wantErr: true},
+		{name: "non-hex digest", ds: dsData("10771", 14, 2, strings.Repeat("X", 64)), wantErr: true},
+		{name: "digest too short", ds: dsData("10771", 14, 2, testDNSSECDigest[:40]), wantErr: true},
+		{name: "not recommended algorithm", ds: dsData("10771", 5, 2, strings.Repeat("A", 64))},
+		{name: "must not sign algorithm", ds: dsData("10771", 3, 2, strings.Repeat("A", 64)), wantWarn: true},
+		{name: "must not digest type", ds: dsData("10771", 13, 1, strings.Repeat("A", 40)), wantWarn: true},
+		{name: "key_data must not sign algorithm", key: keyData(12), wantWarn: true},
+		{name: "matching blocks", ds: dsData("10771", 14, 4, testDNSSECDigest), key: keyData(14)},
+		{name: "mismatched key tag", ds: dsData("10772", 14, 4, testDNSSECDigest), key: keyData(14), wantErr: true},
+		{name: "mismatched algorithm", ds: dsData("10771", 13, 4, testDNSSECDigest), key: keyData(14), wantErr: true},
+		{name: "mismatched digest", ds: dsData("10771", 14, 4, strings.Repeat("A", 96)), key: keyData(14), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			config.Set(ctx, &DNSSECRecordResourceModel{
+				Domain:     types.StringValue("example.com"),
+				MaxSigLife: types.Int64Null(),
+				DSData:     tt.ds,
+				KeyData:    tt.key,
+			})
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarn {
+				t.Errorf("ValidateConfig() warning = %v, want %v: %v", got, tt.wantWarn, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDNSSECRecordResource_ModifyPlan(t *testing.T) {
+	r := &DNSSECRecordResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	server := resolvertest.NewServer(t, "example.com. 3600 IN DNSKEY 257 3 14 "+testDNSSECPublicKey)
+
+	verify := &DNSSECVerifyDNSKEYModel{
+		Resolvers: types.ListValueMust(types.StringType, []attr.Value{types.StringValue(server.Addr)}),
+		Timeout:   types.Int64Value(1),
+	}
+	tests := []struct {
+		name    string
+		ds      *DNSSECDSDataModel
+		key     *DNSSECKeyDataModel
+		wantErr bool
+	}{
+		{
+			name: "live DS",
+			ds:   &DNSSECDSDataModel{KeyTag: types.StringValue("10771"), Algorithm: types.Int64Value(14), DigestType: types.Int64Value(4), Digest: types.StringValue(testDNSSECDigest)},
+		},
+		{
+			name:    "unknown DS",
+			ds:      &DNSSECDSDataModel{KeyTag: types.StringValue("10771"), Algorithm: types.Int64Value(14), DigestType: types.Int64Value(4), Digest: types.StringValue(strings.Repeat("A", 96))},
+			wantErr: true,
+		},
+		{
+			name: "live key",
+			key:  &DNSSECKeyDataModel{Flags: types.Int64Value(257), Protocol: types.Int64Value(3), Algorithm: types.Int64Value(14), PublicKey: types.StringValue(testDNSSECPublicKey)},
+		},
+		{
+			name:    "unknown key",
+			key:     &DNSSECKeyDataModel{Flags: types.Int64Value(256), Protocol: types.Int64Value(3), Algorithm: types.Int64Value(14), PublicKey: types.StringValue(testDNSSECPublicKey)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			plan.Set(ctx, &DNSSECRecordResourceModel{
+				Domain:       types.StringValue("example.com"),
+				MaxSigLife:   types.Int64Null(),
+				DSData:       tt.ds,
+				KeyData:      tt.key,
+				VerifyDNSKEY: verify,
+			})
+
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schemaResp.Schema}}, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ModifyPlan() error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+
+	t.Run("unreachable resolver", func(t *testing.T) {
+		server.SetRcode(dns.RcodeServerFailure)
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		plan.Set(ctx, &DNSSECRecordResourceModel{
+			Domain:       types.StringValue("example.com"),
+			MaxSigLife:   types.Int64Null(),
+			DSData:       tests[0].ds,
+			VerifyDNSKEY: verify,
+		})
+
+		resp := fwresource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schemaResp.Schema}}, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("ModifyPlan() with a failing resolver did not fail")
+		}
+	})
+}
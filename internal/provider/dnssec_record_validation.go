@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnssec"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+// getAttributeFunc reads an attribute of a configuration, plan or state.
+type getAttributeFunc func(ctx context.Context, p path.Path, target any) diag.Diagnostics
+
+// getDNSSECBlocks reads the ds_data and key_data blocks. The blocks are read
+// as objects first, as they may be unknown during validation. A block that is
+// null or unknown is returned as nil.
+func getDNSSECBlocks(ctx context.Context, get getAttributeFunc, diagnostics *diag.Diagnostics) (*DNSSECDSDataModel, *DNSSECKeyDataModel) {
+	var dsObj, keyObj types.Object
+	diagnostics.Append(get(ctx, path.Root("ds_data"), &dsObj)...)
+	diagnostics.Append(get(ctx, path.Root("key_data"), &keyObj)...)
+	if diagnostics.HasError() {
+		return nil, nil
+	}
+
+	var ds *DNSSECDSDataModel
+	if !dsObj.IsNull() && !dsObj.IsUnknown() {
+		ds = &DNSSECDSDataModel{}
+		diagnostics.Append(dsObj.As(ctx, ds, basetypes.ObjectAsOptions{})...)
+	}
+	var key *DNSSECKeyDataModel
+	if !keyObj.IsNull() && !keyObj.IsUnknown() {
+		key = &DNSSECKeyDataModel{}
+		diagnostics.Append(keyObj.As(ctx, key, basetypes.ObjectAsOptions{})...)
+	}
+	return ds, key
+}
+
+// known reports whether all values of the DS data are known.
+func (m *DNSSECDSDataModel) known() bool {
+	return !m.KeyTag.IsUnknown() && !m.Algorithm.IsUnknown() && !m.DigestType.IsUnknown() && !m.Digest.IsUnknown()
+}
+
+// known reports whether all values of the key data are known.
+func (m *DNSSECKeyDataModel) known() bool {
+	return !m.Flags.IsUnknown() && !m.Protocol.IsUnknown() && !m.Algorithm.IsUnknown() && !m.PublicKey.IsUnknown()
+}
+
+// ds converts the DS data to a dnssec.DS. It fails if the key tag is not a
+// decimal number between 0 and 65535.
+func (m *DNSSECDSDataModel) ds() (dnssec.DS, error) {
+	keyTag, err := strconv.ParseUint(m.KeyTag.ValueString(), 10, 16)
+	if err != nil {
+		return dnssec.DS{}, fmt.Errorf("key tag %q must be a decimal number between 0 and 65535", m.KeyTag.ValueString())
+	}
+	return dnssec.DS{
+		KeyTag:     uint16(keyTag),
+		Algorithm:  uint8(m.Algorithm.ValueInt64()),
+		DigestType: uint8(m.DigestType.ValueInt64()),
+		Digest:     m.Digest.ValueString(),
+	}, nil
+}
+
+// validateDNSSECDSData checks the key tag and the digest of the DS data, and
+// warns about algorithms and digest types that RFC 8624 forbids.
+func validateDNSSECDSData(ds *DNSSECDSDataModel, diagnostics *diag.Diagnostics) {
+	if !ds.KeyTag.IsUnknown() {
+		if _, err := strconv.ParseUint(ds.KeyTag.ValueString(), 10, 16); err != nil {
+			diagnostics.AddAttributeError(path.Root("ds_data").AtName("key_tag"), "Invalid Key Tag",
+				fmt.Sprintf("The key tag %q must be a decimal number between 0 and 65535.", ds.KeyTag.ValueString()))
+		}
+	}
+
+	if !ds.Digest.IsUnknown() {
+		digest := ds.Digest.ValueString()
+		if _, err := hex.DecodeString(digest); err != nil {
+			diagnostics.AddAttributeError(path.Root("ds_data").AtName("digest"), "Invalid Digest",
+				fmt.Sprintf("The digest %q must be encoded in hexadecimal.", digest))
+		} else if !ds.DigestType.IsUnknown() {
+			digestType := uint8(ds.DigestType.ValueInt64())
+			if length, ok := dnssec.DigestLength(digestType); ok && len(digest) != length {
+				diagnostics.AddAttributeError(path.Root("ds_data").AtName("digest"), "Invalid Digest",
+					fmt.Sprintf("Digests of digest type %d must be %d hexadecimal characters long, got %d.", digestType, length, len(digest)))
+			}
+		}
+	}
+
+	if !ds.Algorithm.IsUnknown() {
+		warnDNSSECAlgorithm(path.Root("ds_data").AtName("algorithm"), ds.Algorithm.ValueInt64(), diagnostics)
+	}
+	if !ds.DigestType.IsUnknown() && dnssec.DigestTypeMustNotDelegate(uint8(ds.DigestType.ValueInt64())) {
+		diagnostics.AddAttributeWarning(path.Root("ds_data").AtName("digest_type"), "Deprecated DNSSEC Digest Type",
+			fmt.Sprintf("RFC 8624 states that DS records MUST NOT be created with digest type %d. "+
+				"Validating resolvers may ignore it; use digest type 2 (SHA-256) instead.", ds.DigestType.ValueInt64()))
+	}
+}
+
+// validateDNSSECKeyData warns about algorithms of the key data that RFC 8624 forbids.
+func validateDNSSECKeyData(key *DNSSECKeyDataModel, diagnostics *diag.Diagnostics) {
+	if !key.Algorithm.IsUnknown() {
+		warnDNSSECAlgorithm(path.Root("key_data").AtName("algorithm"), key.Algorithm.ValueInt64(), diagnostics)
+	}
+}
+
+func warnDNSSECAlgorithm(p path.Path, algorithm int64, diagnostics *diag.Diagnostics) {
+	if dnssec.AlgorithmMustNotSign(uint8(algorithm)) {
+		diagnostics.AddAttributeWarning(p, "Deprecated DNSSEC Algorithm",
+			fmt.Sprintf("RFC 8624 states that zones MUST NOT be signed with algorithm %d. "+
+				"Validating resolvers may treat the domain as insecure; use algorithm 13 (ECDSA P-256 with SHA-256) or 8 (RSA/SHA-256) instead.", algorithm))
+	}
+}
+
+// checkDSMatchesKey recomputes the key tag, algorithm and, if the domain is
+// known, the digest of the DS data from the key data, and reports an error
+// for every value that differs.
+func checkDSMatchesKey(domain types.String, ds *DNSSECDSDataModel, key *DNSSECKeyDataModel, diagnostics *diag.Diagnostics) {
+	if !ds.known() || !key.known() {
+		return
+	}
+
+	k := key.key()
+	if err := k.Validate(); err != nil {
+		diagnostics.AddAttributeError(path.Root("key_data"), "Invalid Key Data", err.Error())
+		return
+	}
+	want, err := ds.ds()
+	if err != nil {
+		return
+	}
+
+	if keyTag := k.KeyTag(); keyTag != want.KeyTag {
+		diagnostics.AddAttributeError(path.Root("ds_data").AtName("key_tag"), "DS Data Does Not Match Key Data",
+			fmt.Sprintf("The key tag %d does not match the key tag %d computed from key_data.", want.KeyTag, keyTag))
+	}
+	if k.Algorithm != want.Algorithm {
+		diagnostics.AddAttributeError(path.Root("ds_data").AtName("algorithm"), "DS Data Does Not Match Key Data",
+			fmt.Sprintf("The algorithm %d does not match the algorithm %d of key_data.", want.Algorithm, k.Algorithm))
+	}
+
+	// GOST digests are not supported by dnssec.Key.DS, so they cannot be recomputed.
+	if domain.IsNull() || domain.IsUnknown() || want.DigestType == dns.GOST94 {
+		return
+	}
+	computed, err := k.DS(domain.ValueString(), want.DigestType)
+	if err != nil {
+		return
+	}
+	if !strings.EqualFold(computed.Digest, want.Digest) {
+		diagnostics.AddAttributeError(path.Root("ds_data").AtName("digest"), "DS Data Does Not Match Key Data",
+			fmt.Sprintf("The digest does not match the digest %s computed from key_data for %s.", computed.Digest, domain.ValueString()))
+	}
+}
+
+// verifyLiveDNSKEY queries the DNSKEY records of the domain and reports an
+// error if the DS data, or the key data if no DS data is given, matches none
+// of them.
+func verifyLiveDNSKEY(ctx context.Context, domain string, ds *DNSSECDSDataModel, key *DNSSECKeyDataModel, settings *DNSSECVerifyDNSKEYModel, diagnostics *diag.Diagnostics) {
+	if (ds != nil && !ds.known()) || (ds == nil && (key == nil || !key.known())) {
+		return
+	}
+	servers, known := util.StringsFromList(settings.Resolvers)
+	if !known {
+		return
+	}
+
+	var want dnssec.DS
+	if ds != nil {
+		var err error
+		if want, err = ds.ds(); err != nil {
+			return
+		}
+		if want.DigestType == dns.GOST94 {
+			diagnostics.AddAttributeWarning(path.Root("verify_dnskey"), "DS Data Not Verified",
+				"Digests of digest type 3 (GOST R 34.11-94) cannot be computed, so the DS data is not verified against the DNSKEY records of the domain.")
+			return
+		}
+	}
+
+	zone, err := dnsname.ToASCII(domain)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("domain"), "Invalid Domain", err.Error())
+		return
+	}
+	client := resolver.NewClient(time.Duration(settings.Timeout.ValueInt64()) * time.Second)
+	keys, err := dnssec.LookupKeys(ctx, client, servers, zone)
+	if err != nil {
+		diagnostics.AddAttributeError(path.Root("verify_dnskey"), "Error Verifying DNSKEY Records", err.Error())
+		return
+	}
+
+	if ds != nil {
+		for _, k := range keys {
+			if k.Matches(want, domain) {
+				return
+			}
+		}
+		diagnostics.AddAttributeError(path.Root("ds_data"), "DS Data Matches No DNSKEY Record",
+			fmt.Sprintf("The DS data with key tag %d matches none of the %d DNSKEY records of %s. "+
+				"Publishing it would break DNSSEC validation of the domain; publish the key in the zone first.", want.KeyTag, len(keys), domain))
+		return
+	}
+
+	configured := key.key()
+	for _, k := range keys {
+		if k.Flags == configured.Flags && k.Protocol == configured.Protocol && k.Algorithm == configured.Algorithm && samePublicKey(k.PublicKey, configured.PublicKey) {
+			return
+		}
+	}
+	diagnostics.AddAttributeError(path.Root("key_data"), "Key Data Matches No DNSKEY Record",
+		fmt.Sprintf("The key data with key tag %d matches none of the %d DNSKEY records of %s. "+
+			"Publishing it would break DNSSEC validation of the domain; publish the key in the zone first.", configured.KeyTag(), len(keys), domain))
+}
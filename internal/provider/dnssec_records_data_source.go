@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+var _ datasource.DataSource = &DNSSECRecordsDataSource{}
+
+// dnssecRecordObjectAttrs defines the attributes for the DNSSEC record object.
+var dnssecRecordObjectAttrs = map[string]attr.Type{
+	"key_tag":             types.StringType,
+	"algorithm":           types.Int64Type,
+	"digest_type":         types.Int64Type,
+	"digest":              types.StringType,
+	"key_data_flags":      types.Int64Type,
+	"key_data_protocol":   types.Int64Type,
+	"key_data_algorithm":  types.Int64Type,
+	"key_data_public_key": types.StringType,
+}
+
+func NewDNSSECRecordsDataSource() datasource.DataSource {
+	return &DNSSECRecordsDataSource{}
+}
+
+// DNSSECRecordsDataSource defines the data source implementation.
+type DNSSECRecordsDataSource struct {
+	client *porkbun.Client
+}
+
+// DNSSECRecordsDataSourceModel describes the data source data model.
+type DNSSECRecordsDataSourceModel struct {
+	Domain  types.String `tfsdk:"domain"`
+	Enabled types.Bool   `tfsdk:"enabled"`
+	Records types.List   `tfsdk:"records"`
+}
+
+func (d *DNSSECRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_records"
+}
+
+func (d *DNSSECRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the DNSSEC records (DS and DNSKEY data) submitted to the registry for a domain registered with Porkbun, including those created through the web interface.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to retrieve DNSSEC records for (e.g., example.com).",
+				Required:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain has at least one DNSSEC record.",
+				Computed:            true,
+			},
+			"records": schema.ListAttribute{
+				MarkdownDescription: "The DNSSEC records of the domain, ordered by key tag. The DS fields (`algorithm`, `digest_type` and `digest`) " +
+					"and the `key_data_*` fields are null if the registry did not return them.",
+				Computed: true,
+				ElementType: types.ObjectType{
+					AttrTypes: dnssecRecordObjectAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (d *DNSSECRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (d *DNSSECRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSSECRecordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := d.client.Dns.GetDnssecRecords(ctx, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading DNSSEC Records", err.Error())
+		return
+	}
+
+	data.Enabled = types.BoolValue(len(apiResp.Records) > 0)
+	data.Records = convertDNSSECRecordsToList(apiResp.Records, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertDNSSECRecordsToList converts the DNSSEC records returned by the API,
+// keyed by key tag, to a types.List ordered by key tag.
+func convertDNSSECRecordsToList(records map[string]porkbun.DnssecRecordData, diagnostics *diag.Diagnostics) types.List {
+	ids := make([]string, 0, len(records))
+	for id := range records {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, func(a, b string) int {
+		na, errA := strconv.Atoi(a)
+		nb, errB := strconv.Atoi(b)
+		if errA != nil || errB != nil {
+			return cmp.Compare(a, b)
+		}
+		return cmp.Compare(na, nb)
+	})
+
+	return util.MustMapToList(ids, types.ObjectType{AttrTypes: dnssecRecordObjectAttrs}, func(id string) attr.Value {
+		record := records[id]
+
+		ds := dnssecDSDataFromRecord(id, &record, diagnostics)
+		if ds == nil {
+			ds = &DNSSECDSDataModel{
+				KeyTag:     types.StringValue(id),
+				Algorithm:  types.Int64Null(),
+				DigestType: types.Int64Null(),
+				Digest:     types.StringNull(),
+			}
+		}
+		key := dnssecKeyDataFromRecord(&record, diagnostics)
+		if key == nil {
+			key = &DNSSECKeyDataModel{
+				Flags:     types.Int64Null(),
+				Protocol:  types.Int64Null(),
+				Algorithm: types.Int64Null(),
+				PublicKey: types.StringNull(),
+			}
+		}
+
+		return types.ObjectValueMust(
+			dnssecRecordObjectAttrs,
+			map[string]attr.Value{
+				"key_tag":             ds.KeyTag,
+				"algorithm":           ds.Algorithm,
+				"digest_type":         ds.DigestType,
+				"digest":              ds.Digest,
+				"key_data_flags":      key.Flags,
+				"key_data_protocol":   key.Protocol,
+				"key_data_algorithm":  key.Algorithm,
+				"key_data_public_key": key.PublicKey,
+			},
+		)
+	})
+}
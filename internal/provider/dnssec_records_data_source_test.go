@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestAccDNSSECRecordsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSSECRecordsDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.porkbun_dnssec_records.test",
+						tfjsonpath.New("records"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.porkbun_dnssec_records.test",
+						tfjsonpath.New("enabled"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccDNSSECRecordsDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "porkbun_dnssec_records" "test" {
+  domain = %q
+}
+`, testAccDomain())
+}
+
+func TestDNSSECRecordsDataSource_Read(t *testing.T) {
+	api := newFakeAPI()
+	flags, protocol, algorithm, publicKey := "257", "3", porkbun.DnssecAlgorithmEcdsaSha384, testDNSSECPublicKey
+	api.dnssec["example.com"] = map[string]porkbun.DnssecRecordData{
+		"64087": {KeyTag: "64087", Alg: "13", DigestType: "2", Digest: "15E445BD08128BDC213E25F1C8227DF4CB35186CAC701C1C335B2C406D5530DC"},
+		"10771": {KeyDataFlags: &flags, KeyDataProtocol: &protocol, KeyDataAlgo: &algorithm, KeyDataPubKey: &publicKey},
+	}
+	d := &DNSSECRecordsDataSource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(ctx, &DNSSECRecordsDataSourceModel{
+		Domain:  types.StringValue("example.com"),
+		Enabled: types.BoolNull(),
+		Records: types.ListNull(types.ObjectType{AttrTypes: dnssecRecordObjectAttrs}),
+	})
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got DNSSECRecordsDataSourceModel
+	resp.State.Get(ctx, &got)
+	if !got.Enabled.ValueBool() {
+		t.Errorf("enabled = %v, want true", got.Enabled)
+	}
+
+	elems := got.Records.Elements()
+	if len(elems) != 2 {
+		t.Fatalf("records has %d elements, want 2", len(elems))
+	}
+
+	keyOnly := elems[0].(types.Object).Attributes()
+	if keyOnly["key_tag"] != types.StringValue("10771") || !keyOnly["digest"].IsNull() || keyOnly["key_data_flags"] != types.Int64Value(257) ||
+		keyOnly["key_data_public_key"] != types.StringValue(publicKey) {
+		t.Errorf("records[0] = %v, want the key data record with key tag 10771", keyOnly)
+	}
+	dsOnly := elems[1].(types.Object).Attributes()
+	if dsOnly["key_tag"] != types.StringValue("64087") || dsOnly["algorithm"] != types.Int64Value(13) || dsOnly["digest_type"] != types.Int64Value(2) ||
+		!dsOnly["key_data_flags"].IsNull() {
+		t.Errorf("records[1] = %v, want the DS record with key tag 64087", dsOnly)
+	}
+}
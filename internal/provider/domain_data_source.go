@@ -102,9 +102,13 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	domain, err := d.findDomain(ctx, data.Domain.ValueString())
+	domain, ok, err := readDomain(ctx, d.client, data.Domain.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Domain Not Found", fmt.Sprintf("Unable to find domain %s: %s", data.Domain.ValueString(), err))
+		resp.Diagnostics.AddError("Error Reading Domain", err.Error())
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddError("Domain Not Found", fmt.Sprintf("Unable to find domain %s in the account.", data.Domain.ValueString()))
 		return
 	}
 
@@ -124,20 +128,20 @@ func (d *DomainDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-// findDomain retrieves the domain information from the Porkbun API.
-func (d *DomainDataSource) findDomain(ctx context.Context, domainName string) (*porkbun.Domain, error) {
-	domains, err := listDomains(ctx, d.client)
+// readDomain retrieves the domain from the list of domains in the account.
+func readDomain(ctx context.Context, client *porkbun.Client, domainName string) (*porkbun.Domain, bool, error) {
+	domains, err := listDomains(ctx, client)
 	if err != nil {
-		return nil, fmt.Errorf("error paginating domains: %w", err)
+		return nil, false, fmt.Errorf("error paginating domains: %w", err)
 	}
 
 	for _, domain := range domains {
 		if domain.Domain == domainName {
-			return &domain, nil
+			return &domain, true, nil
 		}
 	}
 
-	return nil, fmt.Errorf("domain %s not found", domainName)
+	return nil, false, nil
 }
 
 // convertDomainLabelToObjectValue converts a porkbun.Label to a types.ObjectValue.
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+)
+
+var (
+	_ resource.Resource               = &DomainRegistrationResource{}
+	_ resource.ResourceWithModifyPlan = &DomainRegistrationResource{}
+)
+
+const (
+	// domainRegistrationYearsDefault is the default registration period, in years.
+	domainRegistrationYearsDefault = 1
+	// domainRegistrationYearsMax is the longest registration period allowed by registries.
+	domainRegistrationYearsMax = 10
+)
+
+func NewDomainRegistrationResource() resource.Resource {
+	return &DomainRegistrationResource{}
+}
+
+type DomainRegistrationResource struct {
+	client *porkbun.Client
+	api    *porkbunapi.Client
+}
+
+type DomainRegistrationResourceModel struct {
+	ID       types.String  `tfsdk:"id"`
+	Domain   types.String  `tfsdk:"domain"`
+	Years    types.Int64   `tfsdk:"years"`
+	MaxPrice types.Float64 `tfsdk:"max_price"`
+	Price    types.Float64 `tfsdk:"price"`
+	Premium  types.Bool    `tfsdk:"premium"`
+}
+
+func (r *DomainRegistrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_registration"
+}
+
+func (r *DomainRegistrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Register a domain with Porkbun. The availability and price of the domain are checked at plan time, " +
+			"and the plan fails if the domain is not available or the price exceeds `max_price`. " +
+			"**Registering a domain charges the account balance.** Destroying the resource only removes it from the Terraform state; the domain stays registered and keeps renewing automatically if auto-renew is enabled. " +
+			"If the domain is no longer listed in the account, the resource is kept in the state with a warning, so that it is not registered again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to register (e.g., example.com).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"years": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of years to register the domain for. Defaults to %d.", domainRegistrationYearsDefault),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(domainRegistrationYearsDefault),
+				Validators: []validator.Int64{
+					int64validator.Between(1, domainRegistrationYearsMax),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"max_price": schema.Float64Attribute{
+				MarkdownDescription: "The maximum total price, in US dollars, to pay for registering the domain for `years` years. " +
+					"The plan fails if the quoted price, including premium pricing, exceeds it. Changing it after the domain is registered has no effect.",
+				Required: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"price": schema.Float64Attribute{
+				MarkdownDescription: "The total price, in US dollars, quoted for registering the domain for `years` years.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"premium": schema.BoolAttribute{
+				MarkdownDescription: "Whether the domain is a premium domain, which is priced individually by the registry.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DomainRegistrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+	r.api = getPorkbunAPIClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *DomainRegistrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only domains that are yet to be registered are checked.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.api == nil {
+		return
+	}
+
+	var plan DomainRegistrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Domain.IsUnknown() || plan.Years.IsUnknown() || plan.MaxPrice.IsUnknown() {
+		return
+	}
+
+	availability, err := r.api.CheckDomain(ctx, plan.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking Domain Availability", err.Error())
+		return
+	}
+	cost, ok := plan.checkAvailability(availability, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	plan.Price = types.Float64Value(centsToDollars(cost))
+	plan.Premium = types.BoolValue(availability.Premium)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *DomainRegistrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainRegistrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check again, as the price may have changed since the plan was created.
+	domain := data.Domain.ValueString()
+	availability, err := r.api.CheckDomain(ctx, domain)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Checking Domain Availability", err.Error())
+		return
+	}
+	cost, ok := data.checkAvailability(availability, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// The price is part of the plan, so registering at a different price would
+	// produce a result inconsistent with the plan after the account was charged.
+	if planned := data.Price; !planned.IsNull() && !planned.IsUnknown() {
+		if plannedCost := dollarsToCents(planned.ValueFloat64()); plannedCost != cost {
+			resp.Diagnostics.AddAttributeError(path.Root("price"), "Domain Price Changed",
+				fmt.Sprintf("The price of registering %s for %d year(s) changed from $%s to $%s since the plan was created. "+
+					"The domain was not registered; create a new plan to register it at the current price.",
+					domain, data.Years.ValueInt64(), formatCents(plannedCost), formatCents(cost)))
+			return
+		}
+	}
+
+	if err := r.api.CreateDomain(ctx, domain, data.Years.ValueInt64(), cost); err != nil {
+		resp.Diagnostics.AddError("Error Registering Domain", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(domain)
+	data.Price = types.Float64Value(centsToDollars(cost))
+	data.Premium = types.BoolValue(availability.Premium)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRegistrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainRegistrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, ok, err := readDomain(ctx, r.client, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Domain", err.Error())
+		return
+	}
+	// Removing the resource would plan to register the domain again and charge
+	// the account, so it is kept even if the domain is not listed, e.g. as it
+	// was registered only recently.
+	if !ok {
+		resp.Diagnostics.AddWarning("Registered Domain Not Found",
+			fmt.Sprintf("The domain %s is not listed in the account. It may have been registered only recently, or have expired or been transferred away. "+
+				"The resource is kept in the state so that the domain is not registered again; remove it with terraform state rm if the domain is no longer owned.",
+				data.Domain.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRegistrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only max_price can change without replacing the resource, which has no effect once registered.
+	var data DomainRegistrationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainRegistrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DomainRegistrationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Registered domains cannot be deleted; the domain is only removed from the state.
+	resp.Diagnostics.AddWarning("Domain Remains Registered",
+		fmt.Sprintf("The domain %s was removed from the Terraform state, but it stays registered with Porkbun and keeps renewing automatically if auto-renew is enabled. "+
+			"Disable auto-renew in the Porkbun web interface or with the porkbun_domain resource to let it expire.", data.Domain.ValueString()))
+}
+
+// checkAvailability reports an error if the domain is not available or the
+// price of registering it exceeds max_price. It returns the price in US cents.
+func (m *DomainRegistrationResourceModel) checkAvailability(availability *porkbunapi.DomainAvailability, diagnostics *diag.Diagnostics) (int64, bool) {
+	domain := m.Domain.ValueString()
+	if !availability.Available {
+		diagnostics.AddAttributeError(path.Root("domain"), "Domain Not Available",
+			fmt.Sprintf("The domain %s is not available for registration.", domain))
+		return 0, false
+	}
+
+	years := m.Years.ValueInt64()
+	cost := availability.Cost(years)
+	if maxCost := dollarsToCents(m.MaxPrice.ValueFloat64()); cost > maxCost {
+		detail := fmt.Sprintf("Registering %s for %d year(s) costs $%s, which exceeds max_price of $%s.",
+			domain, years, formatCents(cost), formatCents(maxCost))
+		if availability.Premium {
+			detail += " The domain is a premium domain, which is priced individually by the registry."
+		}
+		diagnostics.AddAttributeError(path.Root("max_price"), "Domain Price Exceeds max_price", detail)
+		return 0, false
+	}
+	return cost, true
+}
+
+// centsToDollars converts a price in US cents to US dollars.
+func centsToDollars(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+// dollarsToCents converts a price in US dollars to US cents.
+func dollarsToCents(dollars float64) int64 {
+	return int64(math.Round(dollars * 100))
+}
+
+// formatCents formats a price in US cents as US dollars, e.g. "9.68".
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
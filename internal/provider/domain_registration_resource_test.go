@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Registering domains charges the account, so there is no acceptance test for
+// porkbun_domain_registration; it is tested against the fake API only.
+
+func newTestDomainRegistrationPlan(ctx context.Context, t *testing.T, r *DomainRegistrationResource, domain string, years int64, maxPrice float64) tfsdk.Plan {
+	t.Helper()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	plan.Set(ctx, &DomainRegistrationResourceModel{
+		ID:       types.StringUnknown(),
+		Domain:   types.StringValue(domain),
+		Years:    types.Int64Value(years),
+		MaxPrice: types.Float64Value(maxPrice),
+		Price:    types.Float64Unknown(),
+		Premium:  types.BoolUnknown(),
+	})
+	return plan
+}
+
+func TestDomainRegistrationResource_ModifyPlan(t *testing.T) {
+	api := newFakeAPI()
+	api.available["example.com"] = fakeAvailability{Price: "9.68", RenewalPrice: "10.37"}
+	api.available["premium.com"] = fakeAvailability{Price: "3200.00", RenewalPrice: "3200.00", Premium: true}
+	r := &DomainRegistrationResource{client: newTestClient(t, api), api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		domain      string
+		years       int64
+		maxPrice    float64
+		wantPrice   float64
+		wantPremium bool
+		wantErr     string
+	}{
+		{name: "one year", domain: "example.com", years: 1, maxPrice: 10, wantPrice: 9.68},
+		{name: "exact price", domain: "example.com", years: 1, maxPrice: 9.68, wantPrice: 9.68},
+		{name: "several years", domain: "example.com", years: 3, maxPrice: 50, wantPrice: 30.42},
+		{name: "several years too expensive", domain: "example.com", years: 3, maxPrice: 30, wantErr: "costs $30.42, which exceeds max_price of $30.00"},
+		{name: "premium", domain: "premium.com", years: 1, maxPrice: 5000, wantPrice: 3200, wantPremium: true},
+		{name: "premium too expensive", domain: "premium.com", years: 1, maxPrice: 20, wantErr: "premium domain"},
+		{name: "not available", domain: "taken.com", years: 1, maxPrice: 20, wantErr: "not available"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := newTestDomainRegistrationPlan(ctx, t, r, tt.domain, tt.years, tt.maxPrice)
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: plan.Schema}}, &resp)
+
+			if tt.wantErr != "" {
+				if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.wantErr) {
+					t.Errorf("ModifyPlan() diagnostics = %v, want error containing %q", resp.Diagnostics, tt.wantErr)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() unexpected error: %v", resp.Diagnostics)
+			}
+
+			var got DomainRegistrationResourceModel
+			resp.Plan.Get(ctx, &got)
+			if got.Price.ValueFloat64() != tt.wantPrice || got.Premium.ValueBool() != tt.wantPremium {
+				t.Errorf("ModifyPlan() price = %v, premium = %v, want %v, %v", got.Price, got.Premium, tt.wantPrice, tt.wantPremium)
+			}
+		})
+	}
+}
+
+func TestDomainRegistrationResource_Lifecycle(t *testing.T) {
+	api := newFakeAPI()
+	api.available["example.com"] = fakeAvailability{Price: "9.68", RenewalPrice: "10.37"}
+	r := &DomainRegistrationResource{client: newTestClient(t, api), api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	plan := newTestDomainRegistrationPlan(ctx, t, r, "example.com", 2, 25)
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+	if len(api.domains) != 1 || api.domains[0].Domain != "example.com" {
+		t.Fatalf("domains after Create() = %v, want example.com", api.domains)
+	}
+
+	var state DomainRegistrationResourceModel
+	createResp.State.Get(ctx, &state)
+	if state.ID.ValueString() != "example.com" || state.Price.ValueFloat64() != 20.05 {
+		t.Errorf("state after Create() = %+v, want price 20.05", state)
+	}
+
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() || readResp.State.Raw.IsNull() {
+		t.Fatalf("Read() = %v, want the registered domain", readResp.Diagnostics)
+	}
+
+	calls := len(api.calls)
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() || deleteResp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("Delete() = %v, want a warning that the domain remains registered", deleteResp.Diagnostics)
+	}
+	if len(api.calls) != calls || len(api.domains) != 1 {
+		t.Errorf("Delete() called the API: %v", api.calls[calls:])
+	}
+
+	// Domains missing from the account, e.g. as they were registered only
+	// recently, are kept so that they are not registered again.
+	api.domains = nil
+	readResp = fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.State.Raw.IsNull() || readResp.Diagnostics.HasError() || readResp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("Read() = %v, want the missing domain kept in the state with a warning", readResp.Diagnostics)
+	}
+}
+
+func TestDomainRegistrationResource_CreatePriceIncrease(t *testing.T) {
+	api := newFakeAPI()
+	api.available["example.com"] = fakeAvailability{Price: "9.68", RenewalPrice: "10.37"}
+	r := &DomainRegistrationResource{client: newTestClient(t, api), api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	plan := newTestDomainRegistrationPlan(ctx, t, r, "example.com", 1, 10)
+
+	// The price changes between plan and apply.
+	api.available["example.com"] = fakeAvailability{Price: "12.00", RenewalPrice: "12.00"}
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &createResp)
+	if !createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() with a price above max_price did not fail")
+	}
+	if slices.Contains(api.calls, "domain/create") || len(api.domains) != 0 {
+		t.Errorf("Create() registered the domain above max_price: %v", api.calls)
+	}
+}
+
+func TestDomainRegistrationResource_CreatePriceChange(t *testing.T) {
+	api := newFakeAPI()
+	api.available["example.com"] = fakeAvailability{Price: "9.68", RenewalPrice: "10.37"}
+	r := &DomainRegistrationResource{client: newTestClient(t, api), api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	plan := newTestDomainRegistrationPlan(ctx, t, r, "example.com", 1, 10)
+	planResp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: plan.Schema}}, &planResp)
+	if planResp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() unexpected error: %v", planResp.Diagnostics)
+	}
+
+	// The price rises between plan and apply, but stays within max_price.
+	api.available["example.com"] = fakeAvailability{Price: "9.90", RenewalPrice: "10.37"}
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: planResp.Plan}, &createResp)
+	if !createResp.Diagnostics.HasError() || !strings.Contains(createResp.Diagnostics.Errors()[0].Detail(), "changed from $9.68 to $9.90") {
+		t.Fatalf("Create() diagnostics = %v, want a price change error", createResp.Diagnostics)
+	}
+	if slices.Contains(api.calls, "domain/create") || len(api.domains) != 0 {
+		t.Errorf("Create() registered the domain at a price different from the plan: %v", api.calls)
+	}
+
+	// Registering at the planned price succeeds.
+	api.available["example.com"] = fakeAvailability{Price: "9.68", RenewalPrice: "10.37"}
+	createResp = fwresource.CreateResponse{State: tfsdk.State{Schema: plan.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: planResp.Plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+var (
+	_ resource.Resource                = &DomainResource{}
+	_ resource.ResourceWithImportState = &DomainResource{}
+)
+
+func NewDomainResource() resource.Resource {
+	return &DomainResource{}
+}
+
+type DomainResource struct {
+	client *porkbun.Client
+	api    *porkbunapi.Client
+}
+
+type DomainResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Domain       types.String `tfsdk:"domain"`
+	AutoRenew    types.Bool   `tfsdk:"auto_renew"`
+	Status       types.String `tfsdk:"status"`
+	TLD          types.String `tfsdk:"tld"`
+	SecurityLock types.Bool   `tfsdk:"security_lock"`
+	WhoisPrivacy types.Bool   `tfsdk:"whois_privacy"`
+	NotLocal     types.Bool   `tfsdk:"not_local"`
+	Labels       types.List   `tfsdk:"labels"`
+}
+
+func (r *DomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *DomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the registrar settings of a domain registered with Porkbun, such as automatic renewal. " +
+			"The resource adopts an existing domain: creating it does not register the domain, and destroying it only removes it from the Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The domain name.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to manage (e.g., example.com). Must be a domain registered with Porkbun in the account.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_renew": schema.BoolAttribute{
+				MarkdownDescription: "Whether automatic renewal is enabled for the domain. When enabled, the domain will be automatically renewed before expiration. " +
+					"If not set, the current setting is left unchanged.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current status of the domain (e.g., 'ACTIVE', 'EXPIRED', etc.).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tld": schema.StringAttribute{
+				MarkdownDescription: "The top-level domain (TLD) of the domain (e.g., 'com', 'org', 'net').",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"whois_privacy": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether WHOIS privacy protection is enabled for the domain, which hides personal contact information in public WHOIS records.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"security_lock": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the domain transfer lock is enabled, which prevents unauthorized domain transfers to other registrars.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"not_local": schema.BoolAttribute{
+				MarkdownDescription: "Indicates if the domain is registered elsewhere but using Porkbun's DNS (true) or if it's registered with Porkbun (false).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "A list of labels associated with the domain. Labels are used to categorize and organize domains within your Porkbun account.",
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: domainLabelObjectAttrs},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+	r.api = getPorkbunAPIClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *DomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, ok, err := readDomain(ctx, r.client, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Domain", err.Error())
+		return
+	}
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("domain"), "Domain Not Found",
+			fmt.Sprintf("Unable to find domain %s in the account. The domain must be registered with Porkbun before its settings can be managed.", data.Domain.ValueString()))
+		return
+	}
+
+	autoRenew := data.AutoRenew
+	data.setDomain(domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !autoRenew.IsUnknown() && !autoRenew.Equal(data.AutoRenew) {
+		if err := r.api.UpdateAutoRenew(ctx, data.Domain.ValueString(), autoRenew.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error Updating Auto-Renew", err.Error())
+			return
+		}
+		data.AutoRenew = autoRenew
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DomainResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, ok, err := readDomain(ctx, r.client, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Domain", err.Error())
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.setDomain(domain, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state DomainResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AutoRenew.IsUnknown() && !plan.AutoRenew.Equal(state.AutoRenew) {
+		if err := r.api.UpdateAutoRenew(ctx, plan.Domain.ValueString(), plan.AutoRenew.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Error Updating Auto-Renew", err.Error())
+			return
+		}
+	}
+	if plan.AutoRenew.IsUnknown() {
+		plan.AutoRenew = state.AutoRenew
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The domain stays registered; it is only removed from the state.
+}
+
+func (r *DomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+}
+
+// setDomain sets the ID and the settings of the model from the domain returned by the API.
+func (m *DomainResourceModel) setDomain(domain *porkbun.Domain, diagnostics *diag.Diagnostics) {
+	m.ID = types.StringValue(domain.Domain)
+	m.Domain = types.StringValue(domain.Domain)
+	m.Status = types.StringValue(domain.Status)
+	m.TLD = types.StringValue(domain.TLD)
+	m.SecurityLock = util.BoolValue(bool(domain.SecurityLock), diagnostics)
+	m.WhoisPrivacy = util.BoolValue(bool(domain.WhoisPrivacy), diagnostics)
+	m.AutoRenew = util.BoolValue(bool(domain.AutoRenew), diagnostics)
+	m.NotLocal = util.BoolValue(bool(domain.NotLocal), diagnostics)
+	m.Labels = util.MustMapToList(domain.Labels, types.ObjectType{AttrTypes: domainLabelObjectAttrs}, convertDomainLabelToObjectValue)
+}
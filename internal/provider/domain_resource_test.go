@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestAccDomainResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDomainResourceConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_domain.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(testAccDomain()),
+					),
+					statecheck.ExpectKnownValue(
+						"porkbun_domain.test",
+						tfjsonpath.New("auto_renew"),
+						knownvalue.Bool(true),
+					),
+					statecheck.ExpectKnownValue(
+						"porkbun_domain.test",
+						tfjsonpath.New("status"),
+						knownvalue.StringExact("ACTIVE"),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "porkbun_domain.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        testAccDomain(),
+				ImportStateVerifyIdentifierAttribute: "domain",
+			},
+			// Update and Read testing
+			{
+				Config: testAccDomainResourceConfig(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_domain.test",
+						tfjsonpath.New("auto_renew"),
+						knownvalue.Bool(false),
+					),
+				},
+			},
+			// Restore the original setting of the test domain.
+			{
+				Config: testAccDomainResourceConfig(true),
+			},
+		},
+	})
+}
+
+func testAccDomainResourceConfig(autoRenew bool) string {
+	return fmt.Sprintf(`
+resource "porkbun_domain" "test" {
+  domain     = %q
+  auto_renew = %t
+}
+`, testAccDomain(), autoRenew)
+}
+
+func TestDomainResource_Lifecycle(t *testing.T) {
+	api := newFakeAPI()
+	api.domains = []porkbun.Domain{
+		{Domain: "example.com", Status: "ACTIVE", TLD: "com", SecurityLock: true, WhoisPrivacy: true, AutoRenew: true},
+	}
+	r := &DomainResource{client: newTestClient(t, api), api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := DomainResourceModel{
+		ID:           types.StringUnknown(),
+		Domain:       types.StringValue("example.com"),
+		AutoRenew:    types.BoolValue(false),
+		Status:       types.StringUnknown(),
+		TLD:          types.StringUnknown(),
+		SecurityLock: types.BoolUnknown(),
+		WhoisPrivacy: types.BoolUnknown(),
+		NotLocal:     types.BoolUnknown(),
+		Labels:       types.ListUnknown(types.ObjectType{AttrTypes: domainLabelObjectAttrs}),
+	}
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+	if api.domains[0].AutoRenew {
+		t.Errorf("auto-renew after Create() = true, want false")
+	}
+
+	var state DomainResourceModel
+	createResp.State.Get(ctx, &state)
+	if state.ID.ValueString() != "example.com" || state.AutoRenew.ValueBool() || state.Status.ValueString() != "ACTIVE" || !state.SecurityLock.ValueBool() {
+		t.Errorf("state after Create() = %+v, want the domain with auto-renew disabled", state)
+	}
+
+	// Changes made outside of Terraform are detected.
+	api.domains[0].AutoRenew = true
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	readResp.State.Get(ctx, &state)
+	if !state.AutoRenew.ValueBool() {
+		t.Errorf("auto_renew after Read() = false, want true")
+	}
+
+	updatePlan := tfsdk.Plan{Schema: schemaResp.Schema}
+	state.AutoRenew = types.BoolValue(false)
+	updatePlan.Set(ctx, &state)
+	updateResp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, fwresource.UpdateRequest{Plan: updatePlan, State: readResp.State}, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() unexpected error: %v", updateResp.Diagnostics)
+	}
+	if api.domains[0].AutoRenew {
+		t.Errorf("auto-renew after Update() = true, want false")
+	}
+
+	calls := len(api.calls)
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: updateResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if len(api.calls) != calls || len(api.domains) != 1 {
+		t.Errorf("Delete() called the API: %v", api.calls[calls:])
+	}
+
+	api.domains = nil
+	r.Read(ctx, fwresource.ReadRequest{State: updateResp.State}, &readResp)
+	if !readResp.State.Raw.IsNull() {
+		t.Errorf("Read() did not remove the missing domain from the state")
+	}
+}
+
+func TestDomainResource_CreateMissingDomain(t *testing.T) {
+	api := newFakeAPI()
+	r := &DomainResource{client: newTestClient(t, api), api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &DomainResourceModel{
+		ID:           types.StringUnknown(),
+		Domain:       types.StringValue("example.com"),
+		AutoRenew:    types.BoolUnknown(),
+		Status:       types.StringUnknown(),
+		TLD:          types.StringUnknown(),
+		SecurityLock: types.BoolUnknown(),
+		WhoisPrivacy: types.BoolUnknown(),
+		NotLocal:     types.BoolUnknown(),
+		Labels:       types.ListUnknown(types.ObjectType{AttrTypes: domainLabelObjectAttrs}),
+	})
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if !createResp.Diagnostics.HasError() {
+		t.Errorf("Create() of a domain not in the account did not fail")
+	}
+}
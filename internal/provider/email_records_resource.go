@@ -0,0 +1,552 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/enumvalidator"
+)
+
+var (
+	_ resource.Resource                   = &EmailRecordsResource{}
+	_ resource.ResourceWithModifyPlan     = &EmailRecordsResource{}
+	_ resource.ResourceWithValidateConfig = &EmailRecordsResource{}
+)
+
+// emailPreset identifies an email provider whose records are managed by EmailRecordsResource.
+type emailPreset string
+
+const (
+	emailPresetGoogleWorkspace emailPreset = "google_workspace"
+	emailPresetMicrosoft365    emailPreset = "microsoft_365"
+	emailPresetFastmail        emailPreset = "fastmail"
+	emailPresetPorkbun         emailPreset = "porkbun"
+)
+
+func NewEmailRecordsResource() resource.Resource {
+	return &EmailRecordsResource{}
+}
+
+// EmailRecordsResource manages the DNS records required by an email provider as a single unit.
+type EmailRecordsResource struct {
+	client          *porkbun.Client
+	nameserverCheck *nameserverCheck
+}
+
+type EmailRecordsResourceModel struct {
+	Domain          types.String             `tfsdk:"domain"`
+	Preset          types.String             `tfsdk:"preset"`
+	TTL             types.Int64              `tfsdk:"ttl"`
+	MicrosoftTenant types.String             `tfsdk:"microsoft_tenant"`
+	SPFIncludes     types.List               `tfsdk:"spf_includes"`
+	SPFAll          types.String             `tfsdk:"spf_all"`
+	DKIM            []EmailRecordsDKIMModel  `tfsdk:"dkim"`
+	DMARC           *EmailRecordsDMARCModel  `tfsdk:"dmarc"`
+	MTASTS          *EmailRecordsMTASTSModel `tfsdk:"mta_sts"`
+	TLSRPT          *EmailRecordsTLSRPTModel `tfsdk:"tls_rpt"`
+	Records         types.List               `tfsdk:"records"`
+}
+
+type EmailRecordsDKIMModel struct {
+	Selector  types.String `tfsdk:"selector"`
+	KeyType   types.String `tfsdk:"key_type"`
+	PublicKey types.String `tfsdk:"public_key"`
+}
+
+type EmailRecordsDMARCModel struct {
+	Policy          types.String `tfsdk:"policy"`
+	SubdomainPolicy types.String `tfsdk:"subdomain_policy"`
+	Percentage      types.Int64  `tfsdk:"percentage"`
+	RUA             types.List   `tfsdk:"rua"`
+	RUF             types.List   `tfsdk:"ruf"`
+	ADKIM           types.String `tfsdk:"adkim"`
+	ASPF            types.String `tfsdk:"aspf"`
+}
+
+type EmailRecordsMTASTSModel struct {
+	PolicyID types.String `tfsdk:"policy_id"`
+}
+
+type EmailRecordsTLSRPTModel struct {
+	RUA types.List `tfsdk:"rua"`
+}
+
+func (r *EmailRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_records"
+}
+
+func (r *EmailRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dmarcPolicyValidator := stringvalidator.OneOf("none", "quarantine", "reject")
+	alignmentValidator := stringvalidator.OneOf("r", "s")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the complete set of DNS records (MX, SPF, DKIM, DMARC, MTA-STS and TLS-RPT) required by an email provider as a single unit. " +
+			"Changes made to any of the records outside of Terraform are detected and reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to create the email records for (e.g., example.com).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preset": schema.StringAttribute{
+				MarkdownDescription: "The email provider whose MX and SPF records to create (google_workspace, microsoft_365, fastmail, porkbun). Use `porkbun` for Porkbun's email forwarding.",
+				Required:            true,
+				Validators: []validator.String{
+					enumvalidator.Valid(emailPresetGoogleWorkspace, emailPresetMicrosoft365, emailPresetFastmail, emailPresetPorkbun),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "The time to live in seconds for all records. The minimum and the default is 600 seconds.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(600),
+				},
+			},
+			"microsoft_tenant": schema.StringAttribute{
+				MarkdownDescription: "The initial `onmicrosoft.com` domain of the Microsoft 365 tenant, without the suffix (e.g., `contoso` for `contoso.onmicrosoft.com`). Required to create the DKIM CNAME records for the `microsoft_365` preset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"spf_includes": schema.ListAttribute{
+				MarkdownDescription: "Additional domains to include in the SPF record, for example for transactional email services.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"spf_all": schema.StringAttribute{
+				MarkdownDescription: "The `all` mechanism terminating the SPF record (`-all`, `~all` or `?all`). Defaults to `~all`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("~all"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("-all", "~all", "?all"),
+				},
+			},
+			"dkim": schema.ListNestedAttribute{
+				MarkdownDescription: "DKIM public keys to publish as TXT records at `<selector>._domainkey`. The `fastmail` preset and the `microsoft_365` preset with `microsoft_tenant` publish their DKIM CNAME records automatically.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"selector": schema.StringAttribute{
+							MarkdownDescription: "The DKIM selector, for example `google`.",
+							Required:            true,
+						},
+						"key_type": schema.StringAttribute{
+							MarkdownDescription: "The key type (`rsa` or `ed25519`). Defaults to `rsa`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("rsa"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("rsa", "ed25519"),
+							},
+						},
+						"public_key": schema.StringAttribute{
+							MarkdownDescription: "The base64-encoded public key, as shown by the email provider. It must be a valid key of type `key_type`.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"dmarc": schema.SingleNestedAttribute{
+				MarkdownDescription: "The DMARC policy to publish at `_dmarc`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"policy": schema.StringAttribute{
+						MarkdownDescription: "The policy for mail failing DMARC checks (none, quarantine, reject).",
+						Required:            true,
+						Validators:          []validator.String{dmarcPolicyValidator},
+					},
+					"subdomain_policy": schema.StringAttribute{
+						MarkdownDescription: "The policy for subdomains (none, quarantine, reject). Defaults to `policy` if omitted.",
+						Optional:            true,
+						Validators:          []validator.String{dmarcPolicyValidator},
+					},
+					"percentage": schema.Int64Attribute{
+						MarkdownDescription: "The percentage of messages the policy is applied to.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"rua": schema.ListAttribute{
+						MarkdownDescription: "Email addresses or `mailto:` URIs to send aggregate reports to. Plain email addresses are converted to `mailto:` URIs.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"ruf": schema.ListAttribute{
+						MarkdownDescription: "Email addresses or `mailto:` URIs to send failure reports to. Plain email addresses are converted to `mailto:` URIs.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"adkim": schema.StringAttribute{
+						MarkdownDescription: "The DKIM alignment mode (`r` for relaxed, `s` for strict).",
+						Optional:            true,
+						Validators:          []validator.String{alignmentValidator},
+					},
+					"aspf": schema.StringAttribute{
+						MarkdownDescription: "The SPF alignment mode (`r` for relaxed, `s` for strict).",
+						Optional:            true,
+						Validators:          []validator.String{alignmentValidator},
+					},
+				},
+			},
+			"mta_sts": schema.SingleNestedAttribute{
+				MarkdownDescription: "Publishes the `_mta-sts` TXT record. The policy file itself must be served separately at `https://mta-sts.<domain>/.well-known/mta-sts.txt`.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"policy_id": schema.StringAttribute{
+						MarkdownDescription: "The policy ID. Change it whenever the policy file changes.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 32),
+						},
+					},
+				},
+			},
+			"tls_rpt": schema.SingleNestedAttribute{
+				MarkdownDescription: "Publishes the `_smtp._tls` TXT record for SMTP TLS reporting.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"rua": schema.ListAttribute{
+						MarkdownDescription: "Email addresses, `mailto:` URIs or `https:` URLs to send reports to. Plain email addresses are converted to `mailto:` URIs.",
+						ElementType:         types.StringType,
+						Required:            true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+			"records": schema.ListAttribute{
+				MarkdownDescription: "The DNS records managed by this resource.",
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: dnsRecordSetObjectAttrs},
+			},
+		},
+	}
+}
+
+func (r *EmailRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+	r.nameserverCheck = getNameserverCheck(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *EmailRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EmailRecordsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.MicrosoftTenant.IsNull() && !data.Preset.IsUnknown() && emailPreset(data.Preset.ValueString()) != emailPresetMicrosoft365 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("microsoft_tenant"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("microsoft_tenant can only be set with the %q preset.", emailPresetMicrosoft365),
+		)
+	}
+
+	for i, dkim := range data.DKIM {
+		if dkim.KeyType.IsUnknown() || dkim.PublicKey.IsUnknown() {
+			continue
+		}
+		// key_type is null in the configuration if the default applies.
+		key := mailauth.DKIMKey{KeyType: dkim.KeyType.ValueString(), PublicKey: dkim.PublicKey.ValueString()}
+		if dkim.KeyType.IsNull() {
+			key.KeyType = "rsa"
+		}
+		if err := key.Validate(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("dkim").AtListIndex(i).AtName("public_key"), "Invalid DKIM Public Key", err.Error())
+		}
+	}
+
+	if data.DMARC != nil {
+		if dmarc, known := data.DMARC.dmarc(); known {
+			if err := dmarc.Validate(); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("dmarc"), "Invalid DMARC Policy", err.Error())
+			}
+		}
+	}
+
+	if data.TLSRPT != nil {
+		if rua, known := util.StringsFromList(data.TLSRPT.RUA); known {
+			if err := mailauth.ValidateTLSRPT(rua); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("tls_rpt").AtName("rua"), "Invalid TLS Reporting Address", err.Error())
+			}
+		}
+	}
+}
+
+func (r *EmailRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.nameserverCheck.checkPlan(ctx, req, &resp.Diagnostics)
+
+	var plan EmailRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, known := plan.desiredRecords()
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), types.ListUnknown(types.ObjectType{AttrTypes: dnsRecordSetObjectAttrs}))...)
+		return
+	}
+
+	var existing []dnsRecordSetEntry
+	if !req.State.Raw.IsNull() {
+		var state EmailRecordsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		existing, _ = dnsRecordSetFromList(ctx, state.Records)
+	}
+
+	planned, _ := matchDNSRecordSet(existing, desired)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), dnsRecordSetToList(planned))...)
+}
+
+func (r *EmailRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EmailRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, _ := data.desiredRecords()
+	records, err := applyDNSRecordSet(ctx, r.client, data.Domain.ValueString(), nil, desired)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Email Records", err.Error())
+	}
+
+	data.Records = dnsRecordSetToList(records)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmailRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EmailRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := dnsRecordSetFromList(ctx, data.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := readDNSRecordSet(ctx, r.client, data.Domain.ValueString(), existing, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Email Records", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(records) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Records = dnsRecordSetToList(records)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmailRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state EmailRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := dnsRecordSetFromList(ctx, state.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, _ := data.desiredRecords()
+	records, err := applyDNSRecordSet(ctx, r.client, data.Domain.ValueString(), existing, desired)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Email Records", err.Error())
+	}
+
+	data.Records = dnsRecordSetToList(records)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmailRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EmailRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := dnsRecordSetFromList(ctx, data.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	remaining, err := deleteDNSRecordSet(ctx, r.client, data.Domain.ValueString(), existing)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Email Records", err.Error())
+		// Keep the records that still exist in state so they can be retried.
+		data.Records = dnsRecordSetToList(remaining)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+// desiredRecords returns the records required by the configured preset and inputs.
+//
+// The second return value is false if any of the inputs is unknown.
+func (m *EmailRecordsResourceModel) desiredRecords() ([]dnsRecordSetEntry, bool) {
+	if m.Domain.IsUnknown() || m.Preset.IsUnknown() || m.TTL.IsUnknown() || m.MicrosoftTenant.IsUnknown() || m.SPFAll.IsUnknown() {
+		return nil, false
+	}
+
+	domain := m.Domain.ValueString()
+	ttl := m.TTL.ValueInt64()
+	newRecord := func(subdomain string, recordType porkbun.DnsRecordType, content string, prio int64) dnsRecordSetEntry {
+		return dnsRecordSetEntry{Subdomain: subdomain, Type: recordType, Content: content, TTL: ttl, Prio: prio}
+	}
+
+	records, spfInclude := emailPresetRecords(emailPreset(m.Preset.ValueString()), domain, m.MicrosoftTenant.ValueString(), newRecord)
+
+	spfIncludes, known := util.StringsFromList(m.SPFIncludes)
+	if !known {
+		return nil, false
+	}
+	spf := mailauth.SPF{
+		Includes: append([]string{spfInclude}, spfIncludes...),
+		All:      m.SPFAll.ValueString(),
+	}
+	records = append(records, newRecord("", porkbun.TXT, spf.String(), 0))
+
+	for _, dkim := range m.DKIM {
+		if dkim.Selector.IsUnknown() || dkim.KeyType.IsUnknown() || dkim.PublicKey.IsUnknown() {
+			return nil, false
+		}
+		content := mailauth.DKIM(dkim.KeyType.ValueString(), dkim.PublicKey.ValueString())
+		records = append(records, newRecord(dkim.Selector.ValueString()+"._domainkey", porkbun.TXT, content, 0))
+	}
+
+	if m.DMARC != nil {
+		dmarc, known := m.DMARC.dmarc()
+		if !known {
+			return nil, false
+		}
+		records = append(records, newRecord("_dmarc", porkbun.TXT, dmarc.String(), 0))
+	}
+
+	if m.MTASTS != nil {
+		if m.MTASTS.PolicyID.IsUnknown() {
+			return nil, false
+		}
+		records = append(records, newRecord("_mta-sts", porkbun.TXT, mailauth.MTASTS(m.MTASTS.PolicyID.ValueString()), 0))
+	}
+
+	if m.TLSRPT != nil {
+		rua, known := util.StringsFromList(m.TLSRPT.RUA)
+		if !known {
+			return nil, false
+		}
+		records = append(records, newRecord("_smtp._tls", porkbun.TXT, mailauth.TLSRPT(rua), 0))
+	}
+
+	return records, true
+}
+
+// dmarc converts the model to a mailauth.DMARC.
+//
+// The second return value is false if any of the values is unknown.
+func (m *EmailRecordsDMARCModel) dmarc() (mailauth.DMARC, bool) {
+	rua, ruaKnown := util.StringsFromList(m.RUA)
+	ruf, rufKnown := util.StringsFromList(m.RUF)
+	if !ruaKnown || !rufKnown || m.Policy.IsUnknown() || m.SubdomainPolicy.IsUnknown() ||
+		m.Percentage.IsUnknown() || m.ADKIM.IsUnknown() || m.ASPF.IsUnknown() {
+		return mailauth.DMARC{}, false
+	}
+	return mailauth.DMARC{
+		Policy:          m.Policy.ValueString(),
+		SubdomainPolicy: m.SubdomainPolicy.ValueString(),
+		Percent:         m.Percentage.ValueInt64Pointer(),
+		RUA:             rua,
+		RUF:             ruf,
+		ADKIM:           m.ADKIM.ValueString(),
+		ASPF:            m.ASPF.ValueString(),
+	}, true
+}
+
+// emailPresetRecords returns the MX and provider-specific records of an email
+// preset, along with the domain to include in the SPF record.
+func emailPresetRecords(
+	preset emailPreset,
+	domain, microsoftTenant string,
+	newRecord func(subdomain string, recordType porkbun.DnsRecordType, content string, prio int64) dnsRecordSetEntry,
+) ([]dnsRecordSetEntry, string) {
+	switch preset {
+	case emailPresetGoogleWorkspace:
+		return []dnsRecordSetEntry{
+			newRecord("", porkbun.MX, "smtp.google.com", 1),
+		}, "_spf.google.com"
+	case emailPresetMicrosoft365:
+		// Microsoft 365 derives host names from the domain with dots replaced by dashes.
+		dashed := strings.ReplaceAll(domain, ".", "-")
+		records := []dnsRecordSetEntry{
+			newRecord("", porkbun.MX, dashed+".mail.protection.outlook.com", 0),
+			newRecord("autodiscover", porkbun.CNAME, "autodiscover.outlook.com", 0),
+		}
+		if microsoftTenant != "" {
+			for _, selector := range []string{"selector1", "selector2"} {
+				target := fmt.Sprintf("%s-%s._domainkey.%s.onmicrosoft.com", selector, dashed, microsoftTenant)
+				records = append(records, newRecord(selector+"._domainkey", porkbun.CNAME, target, 0))
+			}
+		}
+		return records, "spf.protection.outlook.com"
+	case emailPresetFastmail:
+		records := []dnsRecordSetEntry{
+			newRecord("", porkbun.MX, "in1-smtp.messagingengine.com", 10),
+			newRecord("", porkbun.MX, "in2-smtp.messagingengine.com", 20),
+		}
+		for _, selector := range []string{"fm1", "fm2", "fm3"} {
+			target := fmt.Sprintf("%s.%s.dkim.fmhosted.com", selector, domain)
+			records = append(records, newRecord(selector+"._domainkey", porkbun.CNAME, target, 0))
+		}
+		return records, "spf.messagingengine.com"
+	case emailPresetPorkbun:
+		return []dnsRecordSetEntry{
+			newRecord("", porkbun.MX, "fwd1.porkbun.com", 10),
+			newRecord("", porkbun.MX, "fwd2.porkbun.com", 20),
+		}, "_spf.porkbun.com"
+	default:
+		// Unreachable, guaranteed by schema validation.
+		return nil, ""
+	}
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestAccEmailRecordsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccEmailRecordsResourceConfig("porkbun", "none"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_email_records.test",
+						tfjsonpath.New("records"),
+						knownvalue.ListSizeExact(4),
+					),
+					statecheck.ExpectKnownValue(
+						"porkbun_email_records.test",
+						tfjsonpath.New("records").AtSliceIndex(3).AtMapKey("content"),
+						knownvalue.StringExact("v=DMARC1; p=none; rua=mailto:dmarc@"+testAccDomain()),
+					),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccEmailRecordsResourceConfig("fastmail", "reject"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_email_records.test",
+						tfjsonpath.New("records"),
+						knownvalue.ListSizeExact(7),
+					),
+					statecheck.ExpectKnownValue(
+						"porkbun_email_records.test",
+						tfjsonpath.New("records").AtSliceIndex(5).AtMapKey("content"),
+						knownvalue.StringExact("v=spf1 include:spf.messagingengine.com ~all"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccEmailRecordsResourceConfig(preset, policy string) string {
+	return fmt.Sprintf(`
+resource "porkbun_email_records" "test" {
+  domain = %[1]q
+  preset = %[2]q
+
+  dmarc = {
+    policy = %[3]q
+    rua    = ["dmarc@%[1]s"]
+  }
+}
+`, testAccDomain(), preset, policy)
+}
+
+func TestEmailRecordsResourceModel_desiredRecords(t *testing.T) {
+	stringList := func(values ...string) types.List {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	record := func(subdomain string, recordType porkbun.DnsRecordType, content string, prio int64) dnsRecordSetEntry {
+		return dnsRecordSetEntry{Subdomain: subdomain, Type: recordType, Content: content, TTL: 600, Prio: prio}
+	}
+
+	tests := []struct {
+		name  string
+		model EmailRecordsResourceModel
+		want  []dnsRecordSetEntry
+	}{
+		{
+			name: "google workspace",
+			model: EmailRecordsResourceModel{
+				Preset: types.StringValue(string(emailPresetGoogleWorkspace)),
+				DKIM: []EmailRecordsDKIMModel{
+					{Selector: types.StringValue("google"), KeyType: types.StringValue("rsa"), PublicKey: types.StringValue("MIIB")},
+				},
+				DMARC: &EmailRecordsDMARCModel{
+					Policy: types.StringValue("quarantine"),
+					RUA:    stringList("dmarc@example.com"),
+				},
+			},
+			want: []dnsRecordSetEntry{
+				record("", porkbun.MX, "smtp.google.com", 1),
+				record("", porkbun.TXT, "v=spf1 include:_spf.google.com ~all", 0),
+				record("google._domainkey", porkbun.TXT, "v=DKIM1; k=rsa; p=MIIB", 0),
+				record("_dmarc", porkbun.TXT, "v=DMARC1; p=quarantine; rua=mailto:dmarc@example.com", 0),
+			},
+		},
+		{
+			name: "microsoft 365",
+			model: EmailRecordsResourceModel{
+				Preset:          types.StringValue(string(emailPresetMicrosoft365)),
+				MicrosoftTenant: types.StringValue("contoso"),
+				SPFIncludes:     stringList("mailgun.org"),
+				SPFAll:          types.StringValue("-all"),
+			},
+			want: []dnsRecordSetEntry{
+				record("", porkbun.MX, "example-com.mail.protection.outlook.com", 0),
+				record("autodiscover", porkbun.CNAME, "autodiscover.outlook.com", 0),
+				record("selector1._domainkey", porkbun.CNAME, "selector1-example-com._domainkey.contoso.onmicrosoft.com", 0),
+				record("selector2._domainkey", porkbun.CNAME, "selector2-example-com._domainkey.contoso.onmicrosoft.com", 0),
+				record("", porkbun.TXT, "v=spf1 include:spf.protection.outlook.com include:mailgun.org -all", 0),
+			},
+		},
+		{
+			name: "fastmail with mta-sts and tls-rpt",
+			model: EmailRecordsResourceModel{
+				Preset: types.StringValue(string(emailPresetFastmail)),
+				MTASTS: &EmailRecordsMTASTSModel{PolicyID: types.StringValue("20240101")},
+				TLSRPT: &EmailRecordsTLSRPTModel{RUA: stringList("tls@example.com")},
+			},
+			want: []dnsRecordSetEntry{
+				record("", porkbun.MX, "in1-smtp.messagingengine.com", 10),
+				record("", porkbun.MX, "in2-smtp.messagingengine.com", 20),
+				record("fm1._domainkey", porkbun.CNAME, "fm1.example.com.dkim.fmhosted.com", 0),
+				record("fm2._domainkey", porkbun.CNAME, "fm2.example.com.dkim.fmhosted.com", 0),
+				record("fm3._domainkey", porkbun.CNAME, "fm3.example.com.dkim.fmhosted.com", 0),
+				record("", porkbun.TXT, "v=spf1 include:spf.messagingengine.com ~all", 0),
+				record("_mta-sts", porkbun.TXT, "v=STSv1; id=20240101", 0),
+				record("_smtp._tls", porkbun.TXT, "v=TLSRPTv1; rua=mailto:tls@example.com", 0),
+			},
+		},
+		{
+			name: "porkbun forwarding",
+			model: EmailRecordsResourceModel{
+				Preset: types.StringValue(string(emailPresetPorkbun)),
+			},
+			want: []dnsRecordSetEntry{
+				record("", porkbun.MX, "fwd1.porkbun.com", 10),
+				record("", porkbun.MX, "fwd2.porkbun.com", 20),
+				record("", porkbun.TXT, "v=spf1 include:_spf.porkbun.com ~all", 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.model.Domain = types.StringValue("example.com")
+			tt.model.TTL = types.Int64Value(600)
+			if tt.model.SPFAll.IsNull() {
+				tt.model.SPFAll = types.StringValue("~all")
+			}
+
+			got, known := tt.model.desiredRecords()
+			if !known {
+				t.Fatal("desiredRecords() reported unknown values")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("desiredRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmailRecordsResourceModel_desiredRecords_Unknown(t *testing.T) {
+	model := EmailRecordsResourceModel{
+		Domain: types.StringValue("example.com"),
+		Preset: types.StringValue(string(emailPresetGoogleWorkspace)),
+		TTL:    types.Int64Value(600),
+		SPFAll: types.StringValue("~all"),
+		DKIM: []EmailRecordsDKIMModel{
+			{Selector: types.StringValue("google"), KeyType: types.StringValue("rsa"), PublicKey: types.StringUnknown()},
+		},
+	}
+
+	if _, known := model.desiredRecords(); known {
+		t.Error("desiredRecords() expected unknown values to be reported")
+	}
+}
+
+func TestEmailRecordsResource_ValidateConfig(t *testing.T) {
+	r := &EmailRecordsResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stringList := func(values ...string) types.List {
+		elems := make([]attr.Value, len(values))
+		for i, v := range values {
+			elems[i] = types.StringValue(v)
+		}
+		return types.ListValueMust(types.StringType, elems)
+	}
+	dmarc := func(rua, ruf types.List) *EmailRecordsDMARCModel {
+		return &EmailRecordsDMARCModel{
+			Policy:          types.StringValue("reject"),
+			SubdomainPolicy: types.StringNull(),
+			Percentage:      types.Int64Null(),
+			RUA:             rua,
+			RUF:             ruf,
+			ADKIM:           types.StringNull(),
+			ASPF:            types.StringNull(),
+		}
+	}
+	noAddresses := types.ListNull(types.StringType)
+
+	tests := []struct {
+		name    string
+		dkim    []EmailRecordsDKIMModel
+		dmarc   *EmailRecordsDMARCModel
+		tlsRPT  *EmailRecordsTLSRPTModel
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			dkim: []EmailRecordsDKIMModel{
+				{Selector: types.StringValue("mail"), KeyType: types.StringValue("ed25519"), PublicKey: types.StringValue(base64.StdEncoding.EncodeToString(edKey))},
+			},
+			dmarc:  dmarc(stringList("dmarc@example.com"), noAddresses),
+			tlsRPT: &EmailRecordsTLSRPTModel{RUA: stringList("tls@example.com", "https://reports.example.com/tls")},
+		},
+		{
+			name: "invalid dkim public key",
+			dkim: []EmailRecordsDKIMModel{
+				{Selector: types.StringValue("mail"), KeyType: types.StringNull(), PublicKey: types.StringValue("not base64!")},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "invalid dmarc rua",
+			dmarc:   dmarc(stringList("not an address"), noAddresses),
+			wantErr: true,
+		},
+		{
+			name:    "invalid dmarc ruf",
+			dmarc:   dmarc(noAddresses, stringList("https://example.com/dmarc")),
+			wantErr: true,
+		},
+		{
+			name:    "invalid tls-rpt rua",
+			tlsRPT:  &EmailRecordsTLSRPTModel{RUA: stringList("not an address")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			diags := config.Set(ctx, &EmailRecordsResourceModel{
+				Domain:          types.StringValue("example.com"),
+				Preset:          types.StringValue(string(emailPresetGoogleWorkspace)),
+				TTL:             types.Int64Null(),
+				MicrosoftTenant: types.StringNull(),
+				SPFIncludes:     types.ListNull(types.StringType),
+				SPFAll:          types.StringNull(),
+				DKIM:            tt.dkim,
+				DMARC:           tt.dmarc,
+				TLSRPT:          tt.tlsRPT,
+				Records:         types.ListNull(types.ObjectType{AttrTypes: dnsRecordSetObjectAttrs}),
+			})
+			if diags.HasError() {
+				t.Fatalf("Set() unexpected error: %v", diags)
+			}
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, want %v: %v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
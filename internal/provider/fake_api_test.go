@@ -0,0 +1,522 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnssec"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+)
+
+// fakeAPI is an in-memory stand-in for the subset of the Porkbun API used in unit tests.
+type fakeAPI struct {
+	mu sync.Mutex
+
+	nextID  int64
+	domains []porkbun.Domain
+	// available lists the domains that can be registered, by domain.
+	available map[string]fakeAvailability
+	records   map[string][]porkbun.DnsRecord                 // by domain
+	forwards  map[string][]porkbun.UrlForwardData            // by domain
+	ns        map[string][]string                            // by domain
+	glue      map[string][]porkbunapi.GlueRecord             // by domain
+	dnssec    map[string]map[string]porkbun.DnssecRecordData // by domain and key tag
+
+	// calls records the API actions invoked, e.g. "dns/create".
+	calls []string
+	// failures lists API actions that fail with the given message instead of being served.
+	failures map[string]string
+}
+
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{
+		nextID:    1000,
+		available: map[string]fakeAvailability{},
+		failures:  map[string]string{},
+		records:   map[string][]porkbun.DnsRecord{},
+		forwards:  map[string][]porkbun.UrlForwardData{},
+		ns:        map[string][]string{},
+		glue:      map[string][]porkbunapi.GlueRecord{},
+		dnssec:    map[string]map[string]porkbun.DnssecRecordData{},
+	}
+}
+
+// newTestClient returns a Porkbun client whose requests are served by the fake API.
+func newTestClient(t *testing.T, api *fakeAPI) *porkbun.Client {
+	t.Helper()
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var httpClient porkbun.HTTPClient = &rewritingHTTPClient{target: serverURL}
+	return porkbun.NewClient(&porkbun.Options{
+		ApiKey:       "pk1_test",
+		SecretApiKey: "sk1_test",
+		HttpClient:   &httpClient,
+	})
+}
+
+// newTestAPIClient returns a client for the endpoints not covered by the Porkbun
+// client whose requests are served by the fake API.
+func newTestAPIClient(t *testing.T, api *fakeAPI) *porkbunapi.Client {
+	t.Helper()
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return porkbunapi.NewClient(&porkbunapi.Options{
+		HTTPClient:   &rewritingHTTPClient{target: serverURL},
+		APIKey:       "pk1_test",
+		SecretAPIKey: "sk1_test",
+	})
+}
+
+// rewritingHTTPClient sends all requests to the target host instead of the Porkbun API.
+type rewritingHTTPClient struct {
+	target *url.URL
+}
+
+func (c *rewritingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = c.target.Scheme
+	req.URL.Host = c.target.Host
+	return http.DefaultClient.Do(req)
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	segments := strings.Split(strings.TrimPrefix(req.URL.Path, "/api/json/v3/"), "/")
+	if len(segments) < 2 {
+		writeFakeError(w, http.StatusNotFound, "unknown endpoint")
+		return
+	}
+	action := segments[0] + "/" + segments[1]
+	args := segments[2:]
+	f.calls = append(f.calls, action)
+	if message, ok := f.failures[action]; ok {
+		writeFakeError(w, http.StatusInternalServerError, message)
+		return
+	}
+
+	var body map[string]any
+	_ = json.NewDecoder(req.Body).Decode(&body)
+
+	switch action {
+	case "dns/retrieve":
+		f.dnsRetrieve(w, args)
+	case "dns/create":
+		f.dnsCreate(w, args, body)
+	case "dns/edit":
+		f.dnsEdit(w, args, body)
+	case "dns/delete":
+		f.dnsDelete(w, args)
+	case "dns/getDnssecRecords":
+		writeFakeJSON(w, map[string]any{"status": "SUCCESS", "records": f.dnssecOf(args[0])})
+	case "dns/createDnssecRecord":
+		f.dnsCreateDNSSEC(w, args, body)
+	case "dns/deleteDnssecRecord":
+		f.dnsDeleteDNSSEC(w, args)
+	case "domain/listAll":
+		f.domainListAll(w, body)
+	case "domain/checkDomain":
+		f.domainCheck(w, args)
+	case "domain/create":
+		f.domainCreate(w, args, body)
+	case "domain/updateAutoRenew":
+		f.domainUpdateAutoRenew(w, args, body)
+	case "domain/getUrlForwarding":
+		writeFakeJSON(w, map[string]any{"status": "SUCCESS", "forwards": f.forwardsOf(args[0])})
+	case "domain/addUrlForward":
+		f.domainAddURLForward(w, args, body)
+	case "domain/deleteUrlForward":
+		f.domainDeleteURLForward(w, args)
+	case "domain/getNs":
+		writeFakeJSON(w, map[string]any{"status": "SUCCESS", "ns": append([]string{}, f.ns[args[0]]...)})
+	case "domain/updateNs":
+		f.domainUpdateNS(w, args, body)
+	case "domain/getGlue":
+		f.domainGetGlue(w, args)
+	case "domain/createGlue", "domain/updateGlue":
+		f.domainPutGlue(w, action == "domain/createGlue", args, body)
+	case "domain/deleteGlue":
+		f.domainDeleteGlue(w, args)
+	default:
+		writeFakeError(w, http.StatusNotFound, "unknown endpoint "+action)
+	}
+}
+
+// findRecord returns the index of the record with the given ID, or -1.
+func (f *fakeAPI) findRecord(domain string, id int64) int {
+	for i, record := range f.records[domain] {
+		if *record.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *fakeAPI) dnsRetrieve(w http.ResponseWriter, args []string) {
+	domain := args[0]
+	records := f.records[domain]
+	if len(args) > 1 {
+		id, _ := strconv.ParseInt(args[1], 10, 64)
+		records = nil
+		if i := f.findRecord(domain, id); i >= 0 {
+			records = f.records[domain][i : i+1]
+		}
+	}
+
+	out := make([]map[string]any, 0, len(records))
+	for _, record := range records {
+		out = append(out, map[string]any{
+			"id":      strconv.FormatInt(*record.ID, 10),
+			"name":    record.Name,
+			"type":    record.Type,
+			"content": record.Content,
+			"ttl":     record.TTL,
+			"prio":    record.Prio,
+			"notes":   record.Notes,
+		})
+	}
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS", "records": out})
+}
+
+func (f *fakeAPI) dnsCreate(w http.ResponseWriter, args []string, body map[string]any) {
+	f.nextID++
+	id := f.nextID
+	record := recordFromBody(args[0], body)
+	record.ID = &id
+	f.records[args[0]] = append(f.records[args[0]], record)
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS", "id": id})
+}
+
+func (f *fakeAPI) dnsEdit(w http.ResponseWriter, args []string, body map[string]any) {
+	id, _ := strconv.ParseInt(args[1], 10, 64)
+	i := f.findRecord(args[0], id)
+	if i < 0 {
+		writeFakeError(w, http.StatusBadRequest, "record not found")
+		return
+	}
+	record := recordFromBody(args[0], body)
+	record.ID = &id
+	f.records[args[0]][i] = record
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+func (f *fakeAPI) dnsDelete(w http.ResponseWriter, args []string) {
+	id, _ := strconv.ParseInt(args[1], 10, 64)
+	i := f.findRecord(args[0], id)
+	if i < 0 {
+		writeFakeError(w, http.StatusBadRequest, "record not found")
+		return
+	}
+	f.records[args[0]] = append(f.records[args[0]][:i], f.records[args[0]][i+1:]...)
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+// forwardsOf returns the URL forwards of the domain, never nil so it encodes as a JSON array.
+func (f *fakeAPI) forwardsOf(domain string) []porkbun.UrlForwardData {
+	return append([]porkbun.UrlForwardData{}, f.forwards[domain]...)
+}
+
+func (f *fakeAPI) domainAddURLForward(w http.ResponseWriter, args []string, body map[string]any) {
+	f.nextID++
+	forward := porkbun.UrlForwardData{Id: strconv.FormatInt(f.nextID, 10)}
+	forward.Subdomain, _ = body["subdomain"].(string)
+	forward.Location, _ = body["location"].(string)
+	forwardType, _ := body["type"].(string)
+	forward.Type = porkbun.ForwardType(forwardType)
+	forward.IncludePath, _ = body["includePath"].(string)
+	forward.Wildcard, _ = body["wildcard"].(string)
+	f.forwards[args[0]] = append(f.forwards[args[0]], forward)
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+func (f *fakeAPI) domainDeleteURLForward(w http.ResponseWriter, args []string) {
+	forwards := f.forwards[args[0]]
+	for i, forward := range forwards {
+		if forward.Id == args[1] {
+			f.forwards[args[0]] = append(forwards[:i], forwards[i+1:]...)
+			writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+			return
+		}
+	}
+	writeFakeError(w, http.StatusBadRequest, "forward not found")
+}
+
+// recordFromBody converts a create or edit request body into a stored record.
+func recordFromBody(domain string, body map[string]any) porkbun.DnsRecord {
+	name, _ := body["name"].(string)
+	if name == "" {
+		name = domain
+	} else {
+		name += "." + domain
+	}
+	recordType, _ := body["type"].(string)
+	content, _ := body["content"].(string)
+	ttl, _ := body["ttl"].(string)
+	prio, _ := body["prio"].(string)
+	return porkbun.DnsRecord{
+		Name:    name,
+		Type:    porkbun.DnsRecordType(recordType),
+		Content: content,
+		TTL:     ttl,
+		Prio:    prio,
+	}
+}
+
+func (f *fakeAPI) domainUpdateNS(w http.ResponseWriter, args []string, body map[string]any) {
+	values, _ := body["ns"].([]any)
+	ns := make([]string, 0, len(values))
+	for _, value := range values {
+		s, _ := value.(string)
+		ns = append(ns, s)
+	}
+	f.ns[args[0]] = ns
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+func (f *fakeAPI) domainGetGlue(w http.ResponseWriter, args []string) {
+	hosts := make([]any, 0, len(f.glue[args[0]]))
+	for _, record := range f.glue[args[0]] {
+		hosts = append(hosts, []any{record.Host, map[string]any{"v4": record.IPv4, "v6": record.IPv6}})
+	}
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS", "hosts": hosts})
+}
+
+// findGlue returns the index of the glue record of the subdomain, or -1.
+func (f *fakeAPI) findGlue(domain, subdomain string) int {
+	host := subdomain + "." + domain
+	for i, record := range f.glue[domain] {
+		if record.Host == host {
+			return i
+		}
+	}
+	return -1
+}
+
+func (f *fakeAPI) domainPutGlue(w http.ResponseWriter, create bool, args []string, body map[string]any) {
+	i := f.findGlue(args[0], args[1])
+	if create && i >= 0 {
+		writeFakeError(w, http.StatusBadRequest, "glue record already exists")
+		return
+	}
+	if !create && i < 0 {
+		writeFakeError(w, http.StatusBadRequest, "glue record not found")
+		return
+	}
+
+	record := porkbunapi.GlueRecord{Host: args[1] + "." + args[0]}
+	ips, _ := body["ips"].([]any)
+	for _, ip := range ips {
+		s, _ := ip.(string)
+		if strings.Contains(s, ":") {
+			record.IPv6 = append(record.IPv6, s)
+		} else {
+			record.IPv4 = append(record.IPv4, s)
+		}
+	}
+
+	if create {
+		f.glue[args[0]] = append(f.glue[args[0]], record)
+	} else {
+		f.glue[args[0]][i] = record
+	}
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+func (f *fakeAPI) domainDeleteGlue(w http.ResponseWriter, args []string) {
+	i := f.findGlue(args[0], args[1])
+	if i < 0 {
+		writeFakeError(w, http.StatusBadRequest, "glue record not found")
+		return
+	}
+	f.glue[args[0]] = append(f.glue[args[0]][:i], f.glue[args[0]][i+1:]...)
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+func (f *fakeAPI) dnssecOf(domain string) map[string]porkbun.DnssecRecordData {
+	records := map[string]porkbun.DnssecRecordData{}
+	for keyTag, record := range f.dnssec[domain] {
+		records[keyTag] = record
+	}
+	return records
+}
+
+func (f *fakeAPI) dnsCreateDNSSEC(w http.ResponseWriter, args []string, body map[string]any) {
+	var record porkbun.DnssecRecordData
+	raw, _ := json.Marshal(body)
+	if err := json.Unmarshal(raw, &record); err != nil {
+		writeFakeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Like a registry, derive the key tag from the key data if no DS data is given.
+	keyTag := record.KeyTag
+	if keyTag == "" && record.KeyDataPubKey != nil && record.KeyDataAlgo != nil {
+		flags, _ := strconv.ParseUint(derefString(record.KeyDataFlags), 10, 16)
+		protocol, _ := strconv.ParseUint(derefString(record.KeyDataProtocol), 10, 8)
+		algorithm, _ := strconv.ParseUint(string(*record.KeyDataAlgo), 10, 8)
+		key := dnssec.Key{Flags: uint16(flags), Protocol: uint8(protocol), Algorithm: uint8(algorithm), PublicKey: *record.KeyDataPubKey}
+		keyTag = strconv.FormatUint(uint64(key.KeyTag()), 10)
+	}
+	if keyTag == "" {
+		writeFakeError(w, http.StatusBadRequest, "missing key tag")
+		return
+	}
+
+	record.MaxSigLife = ""
+	if f.dnssec[args[0]] == nil {
+		f.dnssec[args[0]] = map[string]porkbun.DnssecRecordData{}
+	}
+	f.dnssec[args[0]][keyTag] = record
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+func (f *fakeAPI) dnsDeleteDNSSEC(w http.ResponseWriter, args []string) {
+	if _, ok := f.dnssec[args[0]][args[1]]; !ok {
+		writeFakeError(w, http.StatusBadRequest, "record not found")
+		return
+	}
+	delete(f.dnssec[args[0]], args[1])
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS"})
+}
+
+// fakeDomainsPageSize is the number of domains returned per domain/listAll request.
+const fakeDomainsPageSize = 1000
+
+func (f *fakeAPI) domainListAll(w http.ResponseWriter, body map[string]any) {
+	start, _ := strconv.Atoi(fmt.Sprint(body["start"]))
+	out := []map[string]any{}
+	for i := start; i < len(f.domains) && i < start+fakeDomainsPageSize; i++ {
+		domain := f.domains[i]
+		out = append(out, map[string]any{
+			"domain":       domain.Domain,
+			"status":       domain.Status,
+			"tld":          domain.TLD,
+			"createDate":   domain.CreateDate.Format(time.DateTime),
+			"expireDate":   domain.ExpireDate.Format(time.DateTime),
+			"securityLock": fakeBool(bool(domain.SecurityLock)),
+			"whoisPrivacy": fakeBool(bool(domain.WhoisPrivacy)),
+			"autoRenew":    fakeBool(bool(domain.AutoRenew)),
+			"notLocal":     fakeBool(bool(domain.NotLocal)),
+			"labels":       domain.Labels,
+		})
+	}
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS", "domains": out})
+}
+
+func (f *fakeAPI) domainUpdateAutoRenew(w http.ResponseWriter, args []string, body map[string]any) {
+	status, _ := body["status"].(string)
+	for i := range f.domains {
+		if f.domains[i].Domain == args[0] {
+			f.domains[i].AutoRenew = porkbun.BoolNumber(status == "on")
+			writeFakeJSON(w, map[string]any{"status": "SUCCESS", "results": map[string]any{
+				args[0]: map[string]any{"status": "SUCCESS", "message": "Auto renew status updated."},
+			}})
+			return
+		}
+	}
+	writeFakeError(w, http.StatusBadRequest, "domain not found")
+}
+
+// fakeAvailability is the price of a domain that can be registered.
+type fakeAvailability struct {
+	Price        string // in US dollars, e.g. "9.68"
+	RenewalPrice string
+	Premium      bool
+}
+
+func (f *fakeAPI) domainCheck(w http.ResponseWriter, args []string) {
+	availability, ok := f.available[args[0]]
+	if !ok {
+		writeFakeJSON(w, map[string]any{"status": "SUCCESS", "response": map[string]any{"avail": "no", "premium": "no"}})
+		return
+	}
+	premium := "no"
+	if availability.Premium {
+		premium = "yes"
+	}
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS", "response": map[string]any{
+		"avail":   "yes",
+		"type":    "registration",
+		"price":   availability.Price,
+		"premium": premium,
+		"additional": map[string]any{
+			"renewal": map[string]any{"type": "renewal", "price": availability.RenewalPrice},
+		},
+	}})
+}
+
+func (f *fakeAPI) domainCreate(w http.ResponseWriter, args []string, body map[string]any) {
+	availability, ok := f.available[args[0]]
+	if !ok {
+		writeFakeError(w, http.StatusBadRequest, "domain is not available")
+		return
+	}
+
+	price, _ := porkbunapi.ParsePrice(availability.Price)
+	renewal, _ := porkbunapi.ParsePrice(availability.RenewalPrice)
+	years, _ := body["years"].(float64)
+	cost, _ := body["cost"].(float64)
+	if years < 1 || int64(cost) != price+int64(years-1)*renewal {
+		writeFakeError(w, http.StatusBadRequest, "cost does not match the price of the domain")
+		return
+	}
+	if body["agreeToTerms"] != "yes" {
+		writeFakeError(w, http.StatusBadRequest, "terms not accepted")
+		return
+	}
+
+	delete(f.available, args[0])
+	tld := args[0][strings.LastIndex(args[0], ".")+1:]
+	f.domains = append(f.domains, porkbun.Domain{Domain: args[0], Status: "ACTIVE", TLD: tld, AutoRenew: true})
+	writeFakeJSON(w, map[string]any{"status": "SUCCESS", "domain": args[0], "cost": int64(cost)})
+}
+
+// fakeBool encodes a boolean the way the API does, as "1" or "0".
+func fakeBool(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func writeFakeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"status": "ERROR", "message": message})
+}
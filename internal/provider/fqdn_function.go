@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+var _ function.Function = &FQDNFunction{}
+
+func NewFQDNFunction() function.Function {
+	return &FQDNFunction{}
+}
+
+// FQDNFunction joins a subdomain and a domain into a fully-qualified domain name.
+type FQDNFunction struct{}
+
+func (f *FQDNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "fqdn"
+}
+
+func (f *FQDNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a fully-qualified domain name",
+		MarkdownDescription: "Joins a subdomain and a domain into the fully-qualified domain name, " +
+			"following the same rules the `subdomain` and `domain` attributes of the resources use. " +
+			"An empty subdomain refers to the domain itself. " +
+			"The result is validated and returned in lower-case ASCII form without a trailing dot.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "subdomain",
+				MarkdownDescription: "The subdomain, for example `www` or `_dmarc`. Use an empty string for the domain itself.",
+			},
+			function.StringParameter{
+				Name:                "domain",
+				MarkdownDescription: "The domain, for example `example.com`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FQDNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subdomain, domain string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &subdomain, &domain))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := dnsname.ToASCII(domain); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid domain: %s", err))
+		return
+	}
+	fqdn, err := dnsname.ToASCII(dnsname.Join(subdomain, domain))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid subdomain: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fqdn))
+}
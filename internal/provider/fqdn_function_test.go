@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFQDNFunction(t *testing.T) {
+	tests := []struct {
+		subdomain string
+		domain    string
+		want      string
+	}{
+		{"", "example.com", "example.com"},
+		{"www", "Example.com.", "www.example.com"},
+		{"_dmarc", "bücher.example", "_dmarc.xn--bcher-kva.example"},
+	}
+	for _, tt := range tests {
+		got, funcErr := runFunction(t, NewFQDNFunction(), types.StringValue(tt.subdomain), types.StringValue(tt.domain))
+		if funcErr != nil {
+			t.Fatalf("Run(%q, %q) unexpected error: %v", tt.subdomain, tt.domain, funcErr)
+		}
+		if !got.Equal(types.StringValue(tt.want)) {
+			t.Errorf("Run(%q, %q) = %v, want %q", tt.subdomain, tt.domain, got, tt.want)
+		}
+	}
+
+	_, funcErr := runFunction(t, NewFQDNFunction(), types.StringValue("www"), types.StringValue(""))
+	if funcErr == nil || funcErr.FunctionArgument == nil || *funcErr.FunctionArgument != 1 {
+		t.Errorf("Run() error = %v, want invalid domain", funcErr)
+	}
+
+	_, funcErr = runFunction(t, NewFQDNFunction(), types.StringValue("-bad-"), types.StringValue("example.com"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "Invalid subdomain") {
+		t.Errorf("Run() error = %v, want invalid subdomain", funcErr)
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// objectArgument provides typed access to the attributes of an object passed to
+// a provider function as a dynamic value. Using a dynamic parameter instead of an
+// object parameter allows callers to omit attributes they don't need.
+type objectArgument struct {
+	position int64
+	// prefix is prepended to attribute names in errors of nested objects.
+	prefix string
+	attrs  map[string]attr.Value
+}
+
+// newObjectArgument unwraps the object or map passed as the argument at the given
+// position. Attributes other than the allowed ones are rejected.
+func newObjectArgument(value types.Dynamic, position int64, allowed ...string) (*objectArgument, *function.FuncError) {
+	var attrs map[string]attr.Value
+	switch v := value.UnderlyingValue().(type) {
+	case types.Object:
+		attrs = v.Attributes()
+	case types.Map:
+		attrs = v.Elements()
+	default:
+		return nil, function.NewArgumentFuncError(position, "Expected an object.")
+	}
+
+	var unexpected []string
+	for name := range attrs {
+		if !slices.Contains(allowed, name) {
+			unexpected = append(unexpected, name)
+		}
+	}
+	if len(unexpected) > 0 {
+		slices.Sort(unexpected)
+		return nil, function.NewArgumentFuncError(position, fmt.Sprintf(
+			"Unsupported attributes %s. Supported attributes are %s.",
+			strings.Join(unexpected, ", "), strings.Join(allowed, ", "),
+		))
+	}
+
+	return &objectArgument{position: position, attrs: attrs}, nil
+}
+
+// value returns the attribute, unwrapping dynamic values. Null attributes are treated as absent.
+func (o *objectArgument) value(name string) (attr.Value, bool) {
+	value, ok := o.attrs[name]
+	if dynamic, isDynamic := value.(types.Dynamic); isDynamic {
+		value = dynamic.UnderlyingValue()
+	}
+	if !ok || value == nil || value.IsNull() {
+		return nil, false
+	}
+	return value, true
+}
+
+// String returns the string attribute, or an empty string if it is absent.
+func (o *objectArgument) String(name string) (string, *function.FuncError) {
+	value, ok := o.value(name)
+	if !ok {
+		return "", nil
+	}
+	s, ok := value.(types.String)
+	if !ok {
+		return "", o.typeError(name, "a string")
+	}
+	return s.ValueString(), nil
+}
+
+// Bool returns the bool attribute, or false if it is absent.
+func (o *objectArgument) Bool(name string) (bool, *function.FuncError) {
+	value, ok := o.value(name)
+	if !ok {
+		return false, nil
+	}
+	b, ok := value.(types.Bool)
+	if !ok {
+		return false, o.typeError(name, "a bool")
+	}
+	return b.ValueBool(), nil
+}
+
+// Int64 returns the whole number attribute, or nil if it is absent.
+func (o *objectArgument) Int64(name string) (*int64, *function.FuncError) {
+	value, ok := o.value(name)
+	if !ok {
+		return nil, nil
+	}
+	var n int64
+	switch v := value.(type) {
+	case types.Number:
+		i, accuracy := v.ValueBigFloat().Int64()
+		if accuracy != 0 {
+			return nil, o.typeError(name, "a whole number")
+		}
+		n = i
+	case types.Int64:
+		n = v.ValueInt64()
+	default:
+		return nil, o.typeError(name, "a number")
+	}
+	return &n, nil
+}
+
+// Elements returns the elements of the list, set or tuple attribute, or nil if it is absent.
+func (o *objectArgument) Elements(name, expected string) ([]attr.Value, *function.FuncError) {
+	value, ok := o.value(name)
+	if !ok {
+		return nil, nil
+	}
+
+	switch v := value.(type) {
+	case types.List:
+		return v.Elements(), nil
+	case types.Set:
+		return v.Elements(), nil
+	case types.Tuple:
+		return v.Elements(), nil
+	default:
+		return nil, o.typeError(name, expected)
+	}
+}
+
+// Object returns the object at the given index of the list attribute name, in
+// which only the allowed attributes are accepted.
+func (o *objectArgument) Object(name string, index int, value attr.Value, allowed ...string) (*objectArgument, *function.FuncError) {
+	element := fmt.Sprintf("%s[%d]", name, index)
+	switch value.(type) {
+	case types.Object, types.Map:
+	default:
+		return nil, o.typeError(element, "an object")
+	}
+
+	nested, funcErr := newObjectArgument(types.DynamicValue(value), o.position, allowed...)
+	if funcErr != nil {
+		return nil, o.Error(element, "%s", funcErr.Text)
+	}
+	nested.prefix = o.prefix + element + "."
+	return nested, nil
+}
+
+// Strings returns the list, set or tuple of strings attribute, or nil if it is absent.
+func (o *objectArgument) Strings(name string) ([]string, *function.FuncError) {
+	elements, funcErr := o.Elements(name, "a list of strings")
+	if elements == nil || funcErr != nil {
+		return nil, funcErr
+	}
+
+	result := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, ok := element.(types.String)
+		if !ok || s.IsNull() {
+			return nil, o.typeError(name, "a list of strings")
+		}
+		result = append(result, s.ValueString())
+	}
+	return result, nil
+}
+
+// Error returns an error for the argument, prefixed with the attribute name.
+func (o *objectArgument) Error(name, format string, args ...any) *function.FuncError {
+	return function.NewArgumentFuncError(o.position, fmt.Sprintf("Invalid %q: ", o.prefix+name)+fmt.Sprintf(format, args...))
+}
+
+func (o *objectArgument) typeError(name, expected string) *function.FuncError {
+	return o.Error(name, "expected %s.", expected)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runFunction calls the provider function with the given arguments and returns its result.
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+
+	var def function.DefinitionResponse
+	f.Definition(context.Background(), function.DefinitionRequest{}, &def)
+
+	result, funcErr := def.Definition.Return.NewResultData(context.Background())
+	if funcErr != nil {
+		t.Fatalf("NewResultData() unexpected error: %v", funcErr)
+	}
+
+	resp := function.RunResponse{Result: result}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(args)}, &resp)
+	return resp.Result.Value(), resp.Error
+}
+
+// dynamicObject returns a dynamic value wrapping an object with the given attributes.
+func dynamicObject(attrs map[string]attr.Value) types.Dynamic {
+	attrTypes := make(map[string]attr.Type, len(attrs))
+	for name, value := range attrs {
+		attrTypes[name] = value.Type(context.Background())
+	}
+	return types.DynamicValue(types.ObjectValueMust(attrTypes, attrs))
+}
+
+// stringTuple returns a tuple of strings, as Terraform passes list literals to dynamic parameters.
+func stringTuple(values ...string) types.Tuple {
+	elemTypes := make([]attr.Type, len(values))
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elemTypes[i] = types.StringType
+		elems[i] = types.StringValue(v)
+	}
+	return types.TupleValueMust(elemTypes, elems)
+}
+
+func TestObjectArgument(t *testing.T) {
+	args, funcErr := newObjectArgument(dynamicObject(map[string]attr.Value{
+		"name":    types.StringValue("example"),
+		"enabled": types.BoolValue(true),
+		"count":   types.NumberValue(big.NewFloat(3)),
+		"list":    stringTuple("a", "b"),
+		"unset":   types.StringNull(),
+	}), 0, "name", "enabled", "count", "list", "unset", "missing")
+	if funcErr != nil {
+		t.Fatalf("newObjectArgument() unexpected error: %v", funcErr)
+	}
+
+	if got, _ := args.String("name"); got != "example" {
+		t.Errorf("String() = %q, want %q", got, "example")
+	}
+	if got, _ := args.Bool("enabled"); !got {
+		t.Errorf("Bool() = %v, want true", got)
+	}
+	if got, _ := args.Int64("count"); got == nil || *got != 3 {
+		t.Errorf("Int64() = %v, want 3", got)
+	}
+	if got, _ := args.Strings("list"); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Strings() = %v, want [a b]", got)
+	}
+	if got, funcErr := args.String("unset"); got != "" || funcErr != nil {
+		t.Errorf("String() of null attribute = %q, %v", got, funcErr)
+	}
+	if got, funcErr := args.Strings("missing"); got != nil || funcErr != nil {
+		t.Errorf("Strings() of missing attribute = %v, %v", got, funcErr)
+	}
+	if _, funcErr := args.Bool("name"); funcErr == nil {
+		t.Error("Bool() of string attribute expected error")
+	}
+}
+
+func TestObjectArgument_Unsupported(t *testing.T) {
+	_, funcErr := newObjectArgument(dynamicObject(map[string]attr.Value{
+		"b": types.StringValue("b"),
+		"a": types.StringValue("a"),
+	}), 0, "c")
+	if funcErr == nil || !strings.Contains(funcErr.Text, "Unsupported attributes a, b") {
+		t.Errorf("newObjectArgument() error = %v, want unsupported attributes", funcErr)
+	}
+
+	_, funcErr = newObjectArgument(types.DynamicValue(types.StringValue("a")), 0)
+	if funcErr == nil {
+		t.Error("newObjectArgument() expected error for non-object")
+	}
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/hostnametypes"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/hostnamevalidator"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/ipvalidator"
+)
+
+var (
+	_ resource.Resource                   = &GlueRecordResource{}
+	_ resource.ResourceWithImportState    = &GlueRecordResource{}
+	_ resource.ResourceWithValidateConfig = &GlueRecordResource{}
+)
+
+func NewGlueRecordResource() resource.Resource {
+	return &GlueRecordResource{}
+}
+
+type GlueRecordResource struct {
+	api *porkbunapi.Client
+}
+
+type GlueRecordResourceModel struct {
+	ID          types.String           `tfsdk:"id"`
+	Domain      types.String           `tfsdk:"domain"`
+	Host        hostnametypes.Hostname `tfsdk:"host"`
+	IPAddresses types.Set              `tfsdk:"ip_addresses"`
+}
+
+func (r *GlueRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glue_record"
+}
+
+func (r *GlueRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage glue records, which publish the IP addresses of nameservers within a domain registered through Porkbun at the registry.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the glue record, in the form `<domain>:<host>`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to create the glue record for (e.g., example.com).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "The fully-qualified host name of the nameserver within the domain (e.g., ns1.example.com).",
+				CustomType:          hostnametypes.HostnameType{},
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					hostnamevalidator.Valid(),
+				},
+			},
+			"ip_addresses": schema.SetAttribute{
+				MarkdownDescription: "The IPv4 and IPv6 addresses of the nameserver.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(ipvalidator.Valid()),
+				},
+			},
+		},
+	}
+}
+
+func (r *GlueRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.api = getPorkbunAPIClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *GlueRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data GlueRecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Domain.IsNull() || data.Domain.IsUnknown() || data.Host.IsNull() || data.Host.IsUnknown() {
+		return
+	}
+	if subdomain, ok := dnsname.Subdomain(data.Host.Normalized(), data.Domain.ValueString()); !ok || subdomain == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Invalid Glue Record Host",
+			fmt.Sprintf("Glue records can only be created for hosts within the domain, such as ns1.%s.", dnsname.Normalize(data.Domain.ValueString())),
+		)
+	}
+}
+
+func (r *GlueRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GlueRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, subdomain := data.Domain.ValueString(), data.subdomain()
+	if err := r.api.CreateGlueRecord(ctx, domain, subdomain, data.ipAddresses()); err != nil {
+		resp.Diagnostics.AddError("Error Creating Glue Record", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(glueRecordID(domain, data.Host.Normalized()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GlueRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, ok, err := readGlueRecord(ctx, r.api, data.Domain.ValueString(), data.Host.Normalized())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Glue Record", err.Error())
+		return
+	}
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Keep the configured notation if the addresses are unchanged, e.g. upper-case IPv6 addresses.
+	addresses := glueRecordAddresses(record)
+	if !sameIPAddresses(data.ipAddresses(), addresses) {
+		data.IPAddresses = util.MustMapToSet(addresses, types.StringType, func(s string) attr.Value { return types.StringValue(s) })
+	}
+	data.ID = types.StringValue(glueRecordID(data.Domain.ValueString(), data.Host.Normalized()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GlueRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.api.UpdateGlueRecord(ctx, data.Domain.ValueString(), data.subdomain(), data.ipAddresses()); err != nil {
+		resp.Diagnostics.AddError("Error Updating Glue Record", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GlueRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GlueRecordResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.api.DeleteGlueRecord(ctx, data.Domain.ValueString(), data.subdomain()); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Glue Record", err.Error())
+	}
+}
+
+func (r *GlueRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	domain, host, ok := strings.Cut(req.ID, ":")
+	if !ok || domain == "" || host == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected format: <domain>:<host>")
+		return
+	}
+
+	// Also accept the host relative to the domain, e.g. "example.com:ns1".
+	if _, ok := dnsname.Subdomain(host, domain); !ok {
+		host = dnsname.Join(host, domain)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &GlueRecordResourceModel{
+		ID:          types.StringValue(glueRecordID(domain, dnsname.Normalize(host))),
+		Domain:      types.StringValue(domain),
+		Host:        hostnametypes.NewHostnameValue(host),
+		IPAddresses: types.SetNull(types.StringType),
+	})...)
+}
+
+// subdomain returns the host relative to the domain, as used by the glue endpoints.
+func (m *GlueRecordResourceModel) subdomain() string {
+	subdomain, _ := dnsname.Subdomain(m.Host.Normalized(), m.Domain.ValueString())
+	return subdomain
+}
+
+// ipAddresses returns the configured addresses with IPv4 addresses first.
+func (m *GlueRecordResourceModel) ipAddresses() []string {
+	addresses := make([]string, 0, len(m.IPAddresses.Elements()))
+	for _, elem := range m.IPAddresses.Elements() {
+		if s, ok := elem.(types.String); ok {
+			addresses = append(addresses, s.ValueString())
+		}
+	}
+	slices.SortStableFunc(addresses, func(a, b string) int {
+		return strings.Count(a, ":") - strings.Count(b, ":")
+	})
+	return addresses
+}
+
+// glueRecordID returns the resource ID of the glue record of the host.
+func glueRecordID(domain, host string) string {
+	return domain + ":" + host
+}
+
+// readGlueRecord retrieves the glue record of the fully-qualified host.
+func readGlueRecord(ctx context.Context, api *porkbunapi.Client, domain, host string) (*porkbunapi.GlueRecord, bool, error) {
+	records, err := api.GetGlueRecords(ctx, domain)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed fetching glue records for domain %s: %w", domain, err)
+	}
+
+	for _, record := range records {
+		if dnsname.Normalize(record.Host) == dnsname.Normalize(host) {
+			return &record, true, nil
+		}
+	}
+
+	return nil, false, nil
+}
+
+// glueRecordAddresses returns the IPv4 and IPv6 addresses of the glue record.
+func glueRecordAddresses(record *porkbunapi.GlueRecord) []string {
+	return slices.Concat(record.IPv4, record.IPv6)
+}
+
+// sameIPAddresses reports whether both slices contain the same addresses,
+// regardless of their order and notation.
+func sameIPAddresses(a, b []string) bool {
+	parse := func(addresses []string) []netip.Addr {
+		parsed := make([]netip.Addr, 0, len(addresses))
+		for _, s := range addresses {
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil
+			}
+			parsed = append(parsed, addr)
+		}
+		slices.SortFunc(parsed, netip.Addr.Compare)
+		return slices.Compact(parsed)
+	}
+	pa, pb := parse(a), parse(b)
+	return pa != nil && pb != nil && slices.Equal(pa, pb)
+}
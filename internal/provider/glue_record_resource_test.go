@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/hostnametypes"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+func TestAccGlueRecordResource(t *testing.T) {
+	host := "acctest-ns1." + testAccDomain()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGlueRecordResourceConfig(host, "192.0.2.1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_glue_record.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(testAccDomain()+":"+host),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:      "porkbun_glue_record.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGlueRecordResourceConfig(host, "192.0.2.2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_glue_record.test",
+						tfjsonpath.New("ip_addresses"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("192.0.2.2"),
+							knownvalue.StringExact("2001:db8::1"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccGlueRecordResourceConfig(host, ipv4 string) string {
+	return fmt.Sprintf(`
+resource "porkbun_glue_record" "test" {
+  domain       = %[1]q
+  host         = %[2]q
+  ip_addresses = [%[3]q, "2001:db8::1"]
+}
+`, testAccDomain(), host, ipv4)
+}
+
+func TestGlueRecordResource_Lifecycle(t *testing.T) {
+	api := newFakeAPI()
+	r := &GlueRecordResource{api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	plan := GlueRecordResourceModel{
+		ID:          types.StringUnknown(),
+		Domain:      types.StringValue("example.com"),
+		Host:        hostnametypes.NewHostnameValue("NS1.example.com"),
+		IPAddresses: util.MustMapToSet([]string{"2001:DB8::1", "192.0.2.1"}, types.StringType, func(s string) attr.Value { return types.StringValue(s) }),
+	}
+	createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+	createReq.Plan.Set(ctx, &plan)
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, createReq, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+
+	want := []porkbunapi.GlueRecord{{Host: "ns1.example.com", IPv4: []string{"192.0.2.1"}, IPv6: []string{"2001:DB8::1"}}}
+	if got := api.glue["example.com"]; !reflect.DeepEqual(got, want) {
+		t.Fatalf("glue records after Create() = %+v, want %+v", got, want)
+	}
+
+	// Porkbun returns IPv6 addresses in lower case, which must not cause a diff.
+	api.glue["example.com"][0].IPv6 = []string{"2001:db8::1"}
+	readResp := fwresource.ReadResponse{State: createResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read() unexpected error: %v", readResp.Diagnostics)
+	}
+	var state GlueRecordResourceModel
+	readResp.State.Get(ctx, &state)
+	if !state.IPAddresses.Equal(plan.IPAddresses) || state.ID.ValueString() != "example.com:ns1.example.com" {
+		t.Errorf("state after Read() = %+v, want the planned addresses", state)
+	}
+
+	plan = state
+	plan.IPAddresses = util.MustMapToSet([]string{"192.0.2.2"}, types.StringType, func(s string) attr.Value { return types.StringValue(s) })
+	updateReq := fwresource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}, State: readResp.State}
+	updateReq.Plan.Set(ctx, &plan)
+	updateResp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, updateReq, &updateResp)
+	if updateResp.Diagnostics.HasError() {
+		t.Fatalf("Update() unexpected error: %v", updateResp.Diagnostics)
+	}
+	if got := api.glue["example.com"][0].IPv4; !reflect.DeepEqual(got, []string{"192.0.2.2"}) {
+		t.Errorf("IPv4 addresses after Update() = %v", got)
+	}
+
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: updateResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if got := api.glue["example.com"]; len(got) != 0 {
+		t.Errorf("glue records after Delete() = %+v, want none", got)
+	}
+
+	readResp = fwresource.ReadResponse{State: updateResp.State}
+	r.Read(ctx, fwresource.ReadRequest{State: updateResp.State}, &readResp)
+	if !readResp.State.Raw.IsNull() {
+		t.Errorf("Read() after Delete() kept the resource in state")
+	}
+}
+
+func TestGlueRecordResource_ImportState(t *testing.T) {
+	r := &GlueRecordResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		id       string
+		wantHost string
+		wantErr  bool
+	}{
+		{id: "example.com:ns1.example.com", wantHost: "ns1.example.com"},
+		{id: "example.com:ns1", wantHost: "ns1.example.com"},
+		{id: "example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			resp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tt.id}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("ImportState() = %v, wantErr %t", resp.Diagnostics, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var state GlueRecordResourceModel
+			resp.State.Get(ctx, &state)
+			if state.Host.ValueString() != tt.wantHost || state.ID.ValueString() != "example.com:"+tt.wantHost {
+				t.Errorf("ImportState() state = %+v, want host %s", state, tt.wantHost)
+			}
+		})
+	}
+}
+
+func TestGlueRecordResource_ValidateConfig(t *testing.T) {
+	r := &GlueRecordResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		host    string
+		wantErr bool
+	}{
+		{host: "ns1.example.com"},
+		{host: "ns1.sub.example.com"},
+		{host: "example.com", wantErr: true},
+		{host: "ns1.example.net", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			config.Set(ctx, &GlueRecordResourceModel{
+				ID:          types.StringNull(),
+				Domain:      types.StringValue("example.com"),
+				Host:        hostnametypes.NewHostnameValue(tt.host),
+				IPAddresses: types.SetNull(types.StringType),
+			})
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateConfig() = %v, wantErr %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+var _ datasource.DataSource = &GlueRecordsDataSource{}
+
+// glueRecordObjectAttrs defines the attributes for the glue record object.
+var glueRecordObjectAttrs = map[string]attr.Type{
+	"host":         types.StringType,
+	"ip_addresses": types.ListType{ElemType: types.StringType},
+}
+
+func NewGlueRecordsDataSource() datasource.DataSource {
+	return &GlueRecordsDataSource{}
+}
+
+// GlueRecordsDataSource defines the data source implementation.
+type GlueRecordsDataSource struct {
+	api *porkbunapi.Client
+}
+
+// GlueRecordsDataSourceModel describes the data source data model.
+type GlueRecordsDataSourceModel struct {
+	Domain      types.String `tfsdk:"domain"`
+	GlueRecords types.List   `tfsdk:"glue_records"`
+}
+
+func (d *GlueRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_glue_records"
+}
+
+func (d *GlueRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the glue records of a domain registered with Porkbun, including those created through the web interface.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to retrieve glue records for (e.g., example.com).",
+				Required:            true,
+			},
+			"glue_records": schema.ListAttribute{
+				MarkdownDescription: "A list of glue records of the domain, each with the fully-qualified `host` and its IPv4 and IPv6 `ip_addresses`.",
+				Computed:            true,
+				ElementType: types.ObjectType{
+					AttrTypes: glueRecordObjectAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (d *GlueRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.api = getPorkbunAPIClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (d *GlueRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GlueRecordsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := d.api.GetGlueRecords(ctx, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Glue Records", err.Error())
+		return
+	}
+
+	data.GlueRecords = convertGlueRecordsToList(records)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertGlueRecordsToList converts a slice of porkbunapi.GlueRecord to a types.List.
+func convertGlueRecordsToList(records []porkbunapi.GlueRecord) types.List {
+	return util.MustMapToList(records, types.ObjectType{AttrTypes: glueRecordObjectAttrs}, func(record porkbunapi.GlueRecord) attr.Value {
+		addresses := glueRecordAddresses(&record)
+		return types.ObjectValueMust(
+			glueRecordObjectAttrs,
+			map[string]attr.Value{
+				"host":         types.StringValue(record.Host),
+				"ip_addresses": util.MustMapToList(addresses, types.StringType, func(s string) attr.Value { return types.StringValue(s) }),
+			},
+		)
+	})
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+)
+
+func TestAccGlueRecordsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlueRecordsDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.porkbun_glue_records.test",
+						tfjsonpath.New("glue_records"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccGlueRecordsDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "porkbun_glue_records" "test" {
+  domain = %q
+}
+`, testAccDomain())
+}
+
+func TestGlueRecordsDataSource_Read(t *testing.T) {
+	api := newFakeAPI()
+	api.glue["example.com"] = []porkbunapi.GlueRecord{
+		{Host: "ns1.example.com", IPv4: []string{"192.0.2.1"}, IPv6: []string{"2001:db8::1"}},
+		{Host: "ns2.example.com", IPv4: []string{"192.0.2.2"}},
+	}
+	d := &GlueRecordsDataSource{api: newTestAPIClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(ctx, &GlueRecordsDataSourceModel{
+		Domain:      types.StringValue("example.com"),
+		GlueRecords: types.ListNull(types.ObjectType{AttrTypes: glueRecordObjectAttrs}),
+	})
+
+	req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() unexpected error: %v", resp.Diagnostics)
+	}
+
+	var got GlueRecordsDataSourceModel
+	resp.State.Get(ctx, &got)
+	if want := convertGlueRecordsToList(api.glue["example.com"]); !got.GlueRecords.Equal(want) {
+		t.Errorf("glue_records = %v, want %v", got.GlueRecords, want)
+	}
+	if n := len(got.GlueRecords.Elements()); n != 2 {
+		t.Errorf("glue_records has %d elements, want 2", n)
+	}
+}
@@ -0,0 +1,632 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/enumvalidator"
+)
+
+var (
+	_ resource.Resource                   = &HostingRecordsResource{}
+	_ resource.ResourceWithModifyPlan     = &HostingRecordsResource{}
+	_ resource.ResourceWithValidateConfig = &HostingRecordsResource{}
+)
+
+// hostingPreset identifies a static hosting service whose records are managed by HostingRecordsResource.
+type hostingPreset string
+
+const (
+	hostingPresetGitHubPages     hostingPreset = "github_pages"
+	hostingPresetNetlify         hostingPreset = "netlify"
+	hostingPresetVercel          hostingPreset = "vercel"
+	hostingPresetCloudflarePages hostingPreset = "cloudflare_pages"
+)
+
+// hostingRedirect identifies which host is forwarded to the other one.
+type hostingRedirect string
+
+const (
+	hostingRedirectWWWToApex hostingRedirect = "www_to_apex"
+	hostingRedirectApexToWWW hostingRedirect = "apex_to_www"
+)
+
+func NewHostingRecordsResource() resource.Resource {
+	return &HostingRecordsResource{}
+}
+
+// HostingRecordsResource manages the DNS records pointing a domain at a static hosting service.
+type HostingRecordsResource struct {
+	client          *porkbun.Client
+	nameserverCheck *nameserverCheck
+}
+
+type HostingRecordsResourceModel struct {
+	Domain       types.String `tfsdk:"domain"`
+	Preset       types.String `tfsdk:"preset"`
+	Target       types.String `tfsdk:"target"`
+	Verification types.String `tfsdk:"verification"`
+	TTL          types.Int64  `tfsdk:"ttl"`
+	Redirect     types.String `tfsdk:"redirect"`
+	Records      types.List   `tfsdk:"records"`
+	URLForwardID types.String `tfsdk:"url_forward_id"`
+}
+
+func (r *HostingRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hosting_records"
+}
+
+func (r *HostingRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manage the DNS records pointing the apex and `www` host of a domain at a static hosting service, optionally forwarding one host to the other. " +
+			"Existing records that would conflict with the managed records are reported during planning. " +
+			"Porkbun's default parking records of newly registered domains are replaced instead.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to create the hosting records for (e.g., example.com).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preset": schema.StringAttribute{
+				MarkdownDescription: "The hosting service to point the domain at (github_pages, netlify, vercel, cloudflare_pages).",
+				Required:            true,
+				Validators: []validator.String{
+					enumvalidator.Valid(hostingPresetGitHubPages, hostingPresetNetlify, hostingPresetVercel, hostingPresetCloudflarePages),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The host name assigned by the hosting service, used as the target of the `www` CNAME record. " +
+					"Required for `github_pages` (e.g., `octocat.github.io`), `netlify` (e.g., `example.netlify.app`) and `cloudflare_pages` (e.g., `example.pages.dev`). " +
+					"Optional for `vercel`, where it overrides the default `cname.vercel-dns.com`.",
+				Optional: true,
+			},
+			"verification": schema.StringAttribute{
+				MarkdownDescription: "The domain verification code provided by the hosting service. " +
+					"Published as a TXT record at `_github-pages-challenge-<owner>` for `github_pages`, `netlify-challenge` for `netlify` and `_vercel` for `vercel`. " +
+					"Not supported for `cloudflare_pages`.",
+				Optional: true,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "The time to live in seconds for all records. The minimum and the default is 600 seconds.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(600),
+				},
+			},
+			"redirect": schema.StringAttribute{
+				MarkdownDescription: "Forward one host to the other using a permanent Porkbun URL forward (www_to_apex, apex_to_www). " +
+					"No DNS records are created for the forwarded host, as it is served by Porkbun's forwarding servers.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(hostingRedirectWWWToApex), string(hostingRedirectApexToWWW)),
+				},
+			},
+			"records": schema.ListAttribute{
+				MarkdownDescription: "The DNS records managed by this resource.",
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: dnsRecordSetObjectAttrs},
+			},
+			"url_forward_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the URL forward created for `redirect`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *HostingRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+	r.nameserverCheck = getNameserverCheck(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *HostingRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HostingRecordsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Preset.IsUnknown() {
+		return
+	}
+
+	preset := hostingPreset(data.Preset.ValueString())
+	if data.Target.IsNull() && preset != hostingPresetVercel {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target"),
+			"Missing Attribute Configuration",
+			fmt.Sprintf("target must be set for the %q preset.", preset),
+		)
+	}
+	if !data.Verification.IsNull() && preset == hostingPresetCloudflarePages {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("verification"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("verification is not supported for the %q preset.", preset),
+		)
+	}
+}
+
+func (r *HostingRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.nameserverCheck.checkPlan(ctx, req, &resp.Diagnostics)
+
+	var plan HostingRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *HostingRecordsResourceModel
+	var existing []dnsRecordSetEntry
+	if !req.State.Raw.IsNull() {
+		state = &HostingRecordsResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		existing, _ = dnsRecordSetFromList(ctx, state.Records)
+	}
+
+	switch {
+	case plan.Redirect.IsNull():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("url_forward_id"), types.StringNull())...)
+	case state != nil && !state.URLForwardID.IsNull() && state.Redirect.Equal(plan.Redirect):
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("url_forward_id"), state.URLForwardID)...)
+	default:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("url_forward_id"), types.StringUnknown())...)
+	}
+
+	desired, known := plan.desiredRecords()
+	if !known {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), types.ListUnknown(types.ObjectType{AttrTypes: dnsRecordSetObjectAttrs}))...)
+		return
+	}
+
+	planned, _ := matchDNSRecordSet(existing, desired)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), dnsRecordSetToList(planned))...)
+
+	// Unchanged resources are not checked, so that records added later do not fail every plan.
+	if r.client != nil && (state == nil || !req.Plan.Raw.Equal(req.State.Raw)) {
+		ownedForwardID := ""
+		if state != nil {
+			ownedForwardID = state.URLForwardID.ValueString()
+		}
+		parking := r.checkConflicts(ctx, &plan, desired, existing, ownedForwardID, &resp.Diagnostics)
+		if len(parking) > 0 {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("domain"),
+				"Parking Records Replaced",
+				fmt.Sprintf("The following default parking records of %s conflict with the hosting records and will be deleted:\n\n- %s",
+					plan.Domain.ValueString(), strings.Join(describeDNSRecords(plan.Domain.ValueString(), parking), "\n- ")),
+			)
+		}
+	}
+}
+
+func (r *HostingRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HostingRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The domain may not have been known during planning, so check again before applying.
+	desired, _ := data.desiredRecords()
+	parking := r.checkConflicts(ctx, &data, desired, nil, "", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := deleteDNSRecords(ctx, r.client, data.Domain.ValueString(), parking); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Parking Records", err.Error())
+		return
+	}
+
+	records, err := applyDNSRecordSet(ctx, r.client, data.Domain.ValueString(), nil, desired)
+	data.Records = dnsRecordSetToList(records)
+	data.URLForwardID = types.StringNull()
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Hosting Records", err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if forward := data.redirectForward(); forward != nil {
+		id, err := addURLForward(ctx, r.client, data.Domain.ValueString(), forward)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Creating URL Forward", err.Error())
+		} else {
+			data.URLForwardID = types.StringValue(id)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostingRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HostingRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := dnsRecordSetFromList(ctx, data.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := readDNSRecordSet(ctx, r.client, data.Domain.ValueString(), existing, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Hosting Records", err.Error())
+		return
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Records = dnsRecordSetToList(records)
+
+	if !data.URLForwardID.IsNull() {
+		_, ok, err := readURLForwardByID(ctx, r.client, data.Domain.ValueString(), data.URLForwardID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading URL Forward", err.Error())
+			return
+		}
+		if !ok {
+			data.URLForwardID = types.StringNull()
+		}
+	}
+
+	if len(records) == 0 && data.URLForwardID.IsNull() {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostingRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HostingRecordsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := dnsRecordSetFromList(ctx, state.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desired, _ := data.desiredRecords()
+	parking := r.checkConflicts(ctx, &data, desired, existing, state.URLForwardID.ValueString(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := deleteDNSRecords(ctx, r.client, data.Domain.ValueString(), parking); err != nil {
+		resp.Diagnostics.AddError("Error Deleting Parking Records", err.Error())
+		return
+	}
+
+	// Remove a forward that is no longer wanted before its host receives new records.
+	forwardID := state.URLForwardID
+	if !forwardID.IsNull() && !data.Redirect.Equal(state.Redirect) {
+		if _, err := r.client.Domains.DeleteDomainUrlForward(ctx, data.Domain.ValueString(), forwardID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error Deleting URL Forward", err.Error())
+			return
+		}
+		forwardID = types.StringNull()
+	}
+
+	records, err := applyDNSRecordSet(ctx, r.client, data.Domain.ValueString(), existing, desired)
+	data.Records = dnsRecordSetToList(records)
+	data.URLForwardID = forwardID
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Hosting Records", err.Error())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	if forward := data.redirectForward(); forward != nil && forwardID.IsNull() {
+		id, err := addURLForward(ctx, r.client, data.Domain.ValueString(), forward)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Creating URL Forward", err.Error())
+		} else {
+			data.URLForwardID = types.StringValue(id)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HostingRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HostingRecordsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	existing, diags := dnsRecordSetFromList(ctx, data.Records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.URLForwardID.IsNull() {
+		if _, err := r.client.Domains.DeleteDomainUrlForward(ctx, data.Domain.ValueString(), data.URLForwardID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Error Deleting URL Forward", err.Error())
+			return
+		}
+	}
+
+	remaining, err := deleteDNSRecordSet(ctx, r.client, data.Domain.ValueString(), existing)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Deleting Hosting Records", err.Error())
+		// Keep the records that still exist in state so they can be retried.
+		data.Records = dnsRecordSetToList(remaining)
+		data.URLForwardID = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+}
+
+// checkConflicts adds an error for every existing record or URL forward of the
+// domain that is not owned by the resource and conflicts with the desired records
+// or the redirect. Porkbun's parking records are not reported; the conflicting
+// ones are returned instead, as they are replaced by the resource.
+func (r *HostingRecordsResource) checkConflicts(
+	ctx context.Context,
+	data *HostingRecordsResourceModel,
+	desired, owned []dnsRecordSetEntry,
+	ownedForwardID string,
+	diagnostics *diag.Diagnostics,
+) []porkbun.DnsRecord {
+	domain := data.Domain.ValueString()
+
+	recordsResp, err := r.client.Dns.GetRecords(ctx, domain, nil)
+	if err != nil {
+		diagnostics.AddError("Error Checking for Conflicting Records", fmt.Sprintf("error fetching DNS records for domain %q: %s", domain, err))
+		return nil
+	}
+	forwardsResp, err := r.client.Domains.GetDomainURLForwarding(ctx, domain)
+	if err != nil {
+		diagnostics.AddError("Error Checking for Conflicting Records", fmt.Sprintf("error fetching URL forwards for domain %q: %s", domain, err))
+		return nil
+	}
+
+	ownedIDs := make(map[int64]bool, len(owned))
+	for _, entry := range owned {
+		ownedIDs[entry.ID] = true
+	}
+	var records, parking []porkbun.DnsRecord
+	for _, record := range recordsResp.Records {
+		switch {
+		case record.ID != nil && ownedIDs[*record.ID]:
+		case record.ID != nil && isPorkbunParkingRecord(record):
+			if len(hostingConflicts(domain, []porkbun.DnsRecord{record}, nil, desired, data.redirectForward())) > 0 {
+				parking = append(parking, record)
+			}
+		default:
+			records = append(records, record)
+		}
+	}
+	var forwards []porkbun.UrlForwardData
+	for _, forward := range forwardsResp.Forwards {
+		if forward.Id != ownedForwardID {
+			forwards = append(forwards, forward)
+		}
+	}
+
+	conflicts := hostingConflicts(domain, records, forwards, desired, data.redirectForward())
+	if len(conflicts) > 0 {
+		diagnostics.AddAttributeError(
+			path.Root("domain"),
+			"Conflicting DNS Records",
+			fmt.Sprintf("The following existing entries of %s conflict with the hosting records and must be removed first:\n\n- %s",
+				domain, strings.Join(conflicts, "\n- ")),
+		)
+	}
+	return parking
+}
+
+// hostingConflicts returns a description of every record or URL forward that
+// conflicts with the desired records or redirect forward.
+//
+// A CNAME record conflicts with any other record at the same host, and address
+// records (A, AAAA, ALIAS) conflict with each other. A forwarded host must not
+// have any address or CNAME records.
+func hostingConflicts(domain string, records []porkbun.DnsRecord, forwards []porkbun.UrlForwardData, desired []dnsRecordSetEntry, forward *porkbun.UrlForward) []string {
+	var conflicts []string
+
+	for _, record := range records {
+		subdomain, _ := dnsname.Subdomain(record.Name, domain)
+		for _, entry := range desired {
+			if entry.Subdomain == subdomain && dnsRecordTypesConflict(entry.Type, record.Type) {
+				conflicts = append(conflicts, fmt.Sprintf("%s record %q at %s conflicts with the %s record to be created",
+					record.Type, record.Content, dnsname.Join(subdomain, domain), entry.Type))
+				break
+			}
+		}
+		if forward != nil && forward.Subdomain == subdomain && (isAddressRecordType(record.Type) || record.Type == porkbun.CNAME) {
+			conflicts = append(conflicts, fmt.Sprintf("%s record %q at %s conflicts with the URL forward to be created",
+				record.Type, record.Content, dnsname.Join(subdomain, domain)))
+		}
+	}
+
+	for _, existing := range forwards {
+		if forward != nil && existing.Subdomain == forward.Subdomain {
+			conflicts = append(conflicts, fmt.Sprintf("URL forward to %q at %s conflicts with the URL forward to be created",
+				existing.Location, dnsname.Join(existing.Subdomain, domain)))
+			continue
+		}
+		for _, entry := range desired {
+			if entry.Subdomain == existing.Subdomain && (isAddressRecordType(entry.Type) || entry.Type == porkbun.CNAME) {
+				conflicts = append(conflicts, fmt.Sprintf("URL forward to %q at %s conflicts with the %s record to be created",
+					existing.Location, dnsname.Join(existing.Subdomain, domain), entry.Type))
+				break
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// dnsRecordTypesConflict reports whether records of both types cannot coexist at the same host.
+func dnsRecordTypesConflict(a, b porkbun.DnsRecordType) bool {
+	if a == porkbun.CNAME || b == porkbun.CNAME {
+		return true
+	}
+	return isAddressRecordType(a) && isAddressRecordType(b)
+}
+
+// porkbunParkingTargets are the hosts that the default records of newly
+// registered domains point at, which serve Porkbun's parking page.
+var porkbunParkingTargets = []string{"pixie.porkbun.com", "uixie.porkbun.com"}
+
+// isPorkbunParkingRecord reports whether the record is one of the ALIAS or
+// CNAME records that Porkbun creates for newly registered domains.
+func isPorkbunParkingRecord(record porkbun.DnsRecord) bool {
+	if record.Type != porkbun.ALIAS && record.Type != porkbun.CNAME {
+		return false
+	}
+	return slices.Contains(porkbunParkingTargets, dnsname.Normalize(record.Content))
+}
+
+// describeDNSRecords returns a description of every record, e.g. `ALIAS record "pixie.porkbun.com" at example.com`.
+func describeDNSRecords(domain string, records []porkbun.DnsRecord) []string {
+	descriptions := make([]string, len(records))
+	for i, record := range records {
+		subdomain, _ := dnsname.Subdomain(record.Name, domain)
+		descriptions[i] = fmt.Sprintf("%s record %q at %s", record.Type, record.Content, dnsname.Join(subdomain, domain))
+	}
+	return descriptions
+}
+
+// deleteDNSRecords deletes the records, which must have been returned by the API.
+func deleteDNSRecords(ctx context.Context, client *porkbun.Client, domain string, records []porkbun.DnsRecord) error {
+	for _, record := range records {
+		if _, err := client.Dns.DeleteRecord(ctx, domain, *record.ID); err != nil {
+			return fmt.Errorf("error deleting %s record %q of domain %q: %w", record.Type, record.Content, domain, err)
+		}
+	}
+	return nil
+}
+
+// isAddressRecordType reports whether records of the type determine the address a host resolves to.
+func isAddressRecordType(t porkbun.DnsRecordType) bool {
+	return t == porkbun.A || t == porkbun.AAAA || t == porkbun.ALIAS
+}
+
+// desiredRecords returns the records required by the configured preset and inputs.
+//
+// The second return value is false if any of the inputs is unknown.
+func (m *HostingRecordsResourceModel) desiredRecords() ([]dnsRecordSetEntry, bool) {
+	if m.Domain.IsUnknown() || m.Preset.IsUnknown() || m.Target.IsUnknown() || m.Verification.IsUnknown() ||
+		m.TTL.IsUnknown() || m.Redirect.IsUnknown() {
+		return nil, false
+	}
+
+	ttl := m.TTL.ValueInt64()
+	newRecord := func(subdomain string, recordType porkbun.DnsRecordType, content string) dnsRecordSetEntry {
+		return dnsRecordSetEntry{Subdomain: subdomain, Type: recordType, Content: content, TTL: ttl}
+	}
+
+	apex, www, verification := hostingPresetRecords(hostingPreset(m.Preset.ValueString()), m.Target.ValueString())
+	redirect := hostingRedirect(m.Redirect.ValueString())
+
+	var records []dnsRecordSetEntry
+	if redirect != hostingRedirectApexToWWW {
+		for _, record := range apex {
+			records = append(records, newRecord("", record.Type, record.Content))
+		}
+	}
+	if redirect != hostingRedirectWWWToApex {
+		records = append(records, newRecord("www", porkbun.CNAME, www))
+	}
+	if !m.Verification.IsNull() && verification != "" {
+		records = append(records, newRecord(verification, porkbun.TXT, m.Verification.ValueString()))
+	}
+
+	return records, true
+}
+
+// redirectForward returns the URL forward for the configured redirect, or nil if none is configured.
+func (m *HostingRecordsResourceModel) redirectForward() *porkbun.UrlForward {
+	domain := m.Domain.ValueString()
+	forward := &porkbun.UrlForward{
+		Type:        porkbun.Permanent,
+		IncludePath: encodeBool(true),
+		Wildcard:    encodeBool(false),
+	}
+
+	switch hostingRedirect(m.Redirect.ValueString()) {
+	case hostingRedirectWWWToApex:
+		forward.Subdomain = "www"
+		forward.Location = "https://" + domain
+	case hostingRedirectApexToWWW:
+		forward.Subdomain = ""
+		forward.Location = "https://www." + domain
+	default:
+		return nil
+	}
+	return forward
+}
+
+// hostingPresetRecords returns the apex records and the www CNAME target of a
+// hosting preset, along with the subdomain of its verification TXT record.
+func hostingPresetRecords(preset hostingPreset, target string) (apex []dnsRecordSetEntry, www, verification string) {
+	record := func(recordType porkbun.DnsRecordType, content string) dnsRecordSetEntry {
+		return dnsRecordSetEntry{Type: recordType, Content: content}
+	}
+
+	switch preset {
+	case hostingPresetGitHubPages:
+		// The challenge record is named after the user or organization owning the site.
+		owner, _, _ := strings.Cut(target, ".")
+		return []dnsRecordSetEntry{
+			record(porkbun.A, "185.199.108.153"),
+			record(porkbun.A, "185.199.109.153"),
+			record(porkbun.A, "185.199.110.153"),
+			record(porkbun.A, "185.199.111.153"),
+			record(porkbun.AAAA, "2606:50c0:8000::153"),
+			record(porkbun.AAAA, "2606:50c0:8001::153"),
+			record(porkbun.AAAA, "2606:50c0:8002::153"),
+			record(porkbun.AAAA, "2606:50c0:8003::153"),
+		}, target, "_github-pages-challenge-" + owner
+	case hostingPresetNetlify:
+		return []dnsRecordSetEntry{
+			record(porkbun.ALIAS, "apex-loadbalancer.netlify.com"),
+		}, target, "netlify-challenge"
+	case hostingPresetVercel:
+		if target == "" {
+			target = "cname.vercel-dns.com"
+		}
+		return []dnsRecordSetEntry{
+			record(porkbun.A, "76.76.21.21"),
+		}, target, "_vercel"
+	case hostingPresetCloudflarePages:
+		return []dnsRecordSetEntry{
+			record(porkbun.ALIAS, target),
+		}, target, ""
+	default:
+		// Unreachable, guaranteed by schema validation.
+		return nil, "", ""
+	}
+}
@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestAccHostingRecordsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccHostingRecordsResourceConfig("vercel", "www_to_apex"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_hosting_records.test",
+						tfjsonpath.New("records"),
+						knownvalue.ListSizeExact(2),
+					),
+					statecheck.ExpectKnownValue(
+						"porkbun_hosting_records.test",
+						tfjsonpath.New("url_forward_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccHostingRecordsResourceConfig("netlify", "apex_to_www"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"porkbun_hosting_records.test",
+						tfjsonpath.New("records").AtSliceIndex(0).AtMapKey("content"),
+						knownvalue.StringExact("example.netlify.app"),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccHostingRecordsResourceConfig(preset, redirect string) string {
+	return fmt.Sprintf(`
+resource "porkbun_hosting_records" "test" {
+  domain       = %[1]q
+  preset       = %[2]q
+  target       = "example.netlify.app"
+  verification = "acceptance-test"
+  redirect     = %[3]q
+}
+`, testAccDomain(), preset, redirect)
+}
+
+func TestHostingRecordsResourceModel_desiredRecords(t *testing.T) {
+	record := func(subdomain string, recordType porkbun.DnsRecordType, content string) dnsRecordSetEntry {
+		return dnsRecordSetEntry{Subdomain: subdomain, Type: recordType, Content: content, TTL: 600}
+	}
+
+	tests := []struct {
+		name         string
+		preset       hostingPreset
+		target       types.String
+		verification types.String
+		redirect     types.String
+		want         []dnsRecordSetEntry
+	}{
+		{
+			name:         "github pages",
+			preset:       hostingPresetGitHubPages,
+			target:       types.StringValue("octocat.github.io"),
+			verification: types.StringValue("abc123"),
+			want: []dnsRecordSetEntry{
+				record("", porkbun.A, "185.199.108.153"),
+				record("", porkbun.A, "185.199.109.153"),
+				record("", porkbun.A, "185.199.110.153"),
+				record("", porkbun.A, "185.199.111.153"),
+				record("", porkbun.AAAA, "2606:50c0:8000::153"),
+				record("", porkbun.AAAA, "2606:50c0:8001::153"),
+				record("", porkbun.AAAA, "2606:50c0:8002::153"),
+				record("", porkbun.AAAA, "2606:50c0:8003::153"),
+				record("www", porkbun.CNAME, "octocat.github.io"),
+				record("_github-pages-challenge-octocat", porkbun.TXT, "abc123"),
+			},
+		},
+		{
+			name:     "netlify with www to apex",
+			preset:   hostingPresetNetlify,
+			target:   types.StringValue("example.netlify.app"),
+			redirect: types.StringValue(string(hostingRedirectWWWToApex)),
+			want: []dnsRecordSetEntry{
+				record("", porkbun.ALIAS, "apex-loadbalancer.netlify.com"),
+			},
+		},
+		{
+			name:         "vercel with apex to www",
+			preset:       hostingPresetVercel,
+			verification: types.StringValue("vc-domain-verify=example.com,123"),
+			redirect:     types.StringValue(string(hostingRedirectApexToWWW)),
+			want: []dnsRecordSetEntry{
+				record("www", porkbun.CNAME, "cname.vercel-dns.com"),
+				record("_vercel", porkbun.TXT, "vc-domain-verify=example.com,123"),
+			},
+		},
+		{
+			name:   "cloudflare pages",
+			preset: hostingPresetCloudflarePages,
+			target: types.StringValue("example.pages.dev"),
+			want: []dnsRecordSetEntry{
+				record("", porkbun.ALIAS, "example.pages.dev"),
+				record("www", porkbun.CNAME, "example.pages.dev"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := HostingRecordsResourceModel{
+				Domain:       types.StringValue("example.com"),
+				Preset:       types.StringValue(string(tt.preset)),
+				Target:       tt.target,
+				Verification: tt.verification,
+				TTL:          types.Int64Value(600),
+				Redirect:     tt.redirect,
+			}
+
+			got, known := model.desiredRecords()
+			if !known {
+				t.Fatal("desiredRecords() reported unknown values")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("desiredRecords() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostingConflicts(t *testing.T) {
+	desired := []dnsRecordSetEntry{
+		{Type: porkbun.A, Content: "76.76.21.21"},
+		{Subdomain: "_vercel", Type: porkbun.TXT, Content: "verify"},
+	}
+	wwwForward := &porkbun.UrlForward{Subdomain: "www", Location: "https://example.com"}
+
+	tests := []struct {
+		name     string
+		records  []porkbun.DnsRecord
+		forwards []porkbun.UrlForwardData
+		forward  *porkbun.UrlForward
+		want     int
+	}{
+		{
+			name: "unrelated records",
+			records: []porkbun.DnsRecord{
+				{Name: "example.com", Type: porkbun.MX, Content: "mx.example.net"},
+				{Name: "example.com", Type: porkbun.TXT, Content: "v=spf1 -all"},
+				{Name: "_vercel.example.com", Type: porkbun.TXT, Content: "other"},
+				{Name: "blog.example.com", Type: porkbun.CNAME, Content: "example.net"},
+			},
+			forward: wwwForward,
+		},
+		{
+			name: "address records at apex",
+			records: []porkbun.DnsRecord{
+				{Name: "example.com", Type: porkbun.ALIAS, Content: "pixie.porkbun.com"},
+				{Name: "example.com", Type: porkbun.AAAA, Content: "2001:db8::1"},
+			},
+			want: 2,
+		},
+		{
+			name: "CNAME at verification host",
+			records: []porkbun.DnsRecord{
+				{Name: "_vercel.example.com", Type: porkbun.CNAME, Content: "example.net"},
+			},
+			want: 1,
+		},
+		{
+			name: "records at forwarded host",
+			records: []porkbun.DnsRecord{
+				{Name: "www.example.com", Type: porkbun.CNAME, Content: "example.com"},
+			},
+			forward: wwwForward,
+			want:    1,
+		},
+		{
+			name: "existing URL forwards",
+			forwards: []porkbun.UrlForwardData{
+				{Id: "1", UrlForward: porkbun.UrlForward{Subdomain: "www", Location: "https://example.net"}},
+				{Id: "2", UrlForward: porkbun.UrlForward{Subdomain: "", Location: "https://example.net"}},
+			},
+			forward: wwwForward,
+			want:    2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := hostingConflicts("example.com", tt.records, tt.forwards, desired, tt.forward)
+			if len(got) != tt.want {
+				t.Errorf("hostingConflicts() = %q, want %d conflicts", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostingRecordsResource_checkConflicts(t *testing.T) {
+	api := newFakeAPI()
+	r := &HostingRecordsResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	model := HostingRecordsResourceModel{
+		Domain:   types.StringValue("example.com"),
+		Preset:   types.StringValue(string(hostingPresetVercel)),
+		TTL:      types.Int64Value(600),
+		Redirect: types.StringValue(string(hostingRedirectWWWToApex)),
+	}
+	desired, _ := model.desiredRecords()
+
+	owned, err := applyDNSRecordSet(ctx, r.client, "example.com", nil, desired)
+	if err != nil {
+		t.Fatalf("applyDNSRecordSet() unexpected error: %v", err)
+	}
+	forwardID, err := addURLForward(ctx, r.client, "example.com", model.redirectForward())
+	if err != nil {
+		t.Fatalf("addURLForward() unexpected error: %v", err)
+	}
+
+	var diags diag.Diagnostics
+	r.checkConflicts(ctx, &model, desired, owned, forwardID, &diags)
+	if diags.HasError() {
+		t.Fatalf("checkConflicts() reported conflicts with owned records: %v", diags)
+	}
+
+	r.checkConflicts(ctx, &model, desired, nil, "", &diags)
+	if got := diags.ErrorsCount(); got != 1 {
+		t.Fatalf("checkConflicts() reported %d errors, want 1: %v", got, diags)
+	}
+}
+
+func TestHostingRecordsResource_ParkingRecords(t *testing.T) {
+	api := newFakeAPI()
+	r := &HostingRecordsResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	aliasID, wildcardID := int64(1), int64(2)
+	api.records["example.com"] = []porkbun.DnsRecord{
+		{ID: &aliasID, Name: "example.com", Type: porkbun.ALIAS, Content: "pixie.porkbun.com", TTL: "600"},
+		{ID: &wildcardID, Name: "*.example.com", Type: porkbun.CNAME, Content: "pixie.porkbun.com", TTL: "600"},
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	plan.Set(ctx, &HostingRecordsResourceModel{
+		Domain:       types.StringValue("example.com"),
+		Preset:       types.StringValue(string(hostingPresetVercel)),
+		Target:       types.StringNull(),
+		Verification: types.StringNull(),
+		TTL:          types.Int64Value(600),
+		Redirect:     types.StringNull(),
+		Records:      types.ListUnknown(types.ObjectType{AttrTypes: dnsRecordSetObjectAttrs}),
+		URLForwardID: types.StringUnknown(),
+	})
+
+	modifyResp := fwresource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: tfsdk.State{Schema: schemaResp.Schema}}, &modifyResp)
+	if modifyResp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() unexpected error: %v", modifyResp.Diagnostics)
+	}
+	if got := modifyResp.Diagnostics.WarningsCount(); got != 1 {
+		t.Errorf("ModifyPlan() reported %d warnings, want 1 for the replaced parking record: %v", got, modifyResp.Diagnostics)
+	}
+
+	createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: modifyResp.Plan}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+	}
+	var contents []string
+	for _, record := range api.records["example.com"] {
+		contents = append(contents, string(record.Type)+" "+record.Name+" "+record.Content)
+	}
+	want := []string{"CNAME *.example.com pixie.porkbun.com", "A example.com 76.76.21.21", "CNAME www.example.com cname.vercel-dns.com"}
+	if !slices.Equal(contents, want) {
+		t.Errorf("records after Create() = %q, want %q", contents, want)
+	}
+
+	// Unchanged resources are not checked for conflicts.
+	api.calls = nil
+	state := createResp.State
+	unchangedResp := fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}}
+	r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw}, State: state}, &unchangedResp)
+	if unchangedResp.Diagnostics.HasError() || len(api.calls) != 0 {
+		t.Errorf("ModifyPlan() of an unchanged resource called %v: %v", api.calls, unchangedResp.Diagnostics)
+	}
+}
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+// nameserverCheck reports DNS records planned for domains that are not
+// delegated to Porkbun, as such records are never served. The nameservers of
+// each domain are looked up once per provider instance, i.e. per run; failed
+// lookups are retried by the next check.
+type nameserverCheck struct {
+	client *porkbun.Client
+	// strict reports the problem as an error instead of a warning.
+	strict bool
+
+	mu      sync.Mutex
+	lookups map[string]*nameserverLookup
+}
+
+// nameserverLookup is the cached result of looking up the nameservers of a
+// domain. Only successful lookups are cached, as errors may be caused by the
+// context of a single request being cancelled.
+type nameserverLookup struct {
+	mu          sync.Mutex
+	done        bool
+	nameservers []string
+}
+
+func newNameserverCheck(client *porkbun.Client, strict bool) *nameserverCheck {
+	return &nameserverCheck{
+		client:  client,
+		strict:  strict,
+		lookups: map[string]*nameserverLookup{},
+	}
+}
+
+// checkPlan runs check for the domain attribute of resources that are created
+// or changed by the plan. Unchanged resources are skipped, so that existing
+// records do not fail every plan in strict mode.
+func (c *nameserverCheck) checkPlan(ctx context.Context, req resource.ModifyPlanRequest, diagnostics *diag.Diagnostics) {
+	if c == nil || req.Plan.Raw.IsNull() || (!req.State.Raw.IsNull() && req.Plan.Raw.Equal(req.State.Raw)) {
+		return
+	}
+
+	var domain types.String
+	diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	if domain.IsNull() || domain.IsUnknown() {
+		return
+	}
+
+	c.check(ctx, domain.ValueString(), path.Root("domain"), diagnostics)
+}
+
+// check adds a diagnostic for the attribute if the domain is delegated to
+// nameservers other than Porkbun's. It does nothing if c is nil.
+func (c *nameserverCheck) check(ctx context.Context, domain string, attrPath path.Path, diagnostics *diag.Diagnostics) {
+	if c == nil || c.client == nil || domain == "" {
+		return
+	}
+
+	nameservers, err := c.nameservers(ctx, domain)
+	if err != nil {
+		diagnostics.AddAttributeWarning(
+			attrPath,
+			"Unable to Check Nameservers",
+			fmt.Sprintf("Could not verify that %s is delegated to Porkbun's nameservers: %s", domain, err),
+		)
+		return
+	}
+
+	// Domains without nameservers are not delegated at all, so there is no
+	// other DNS provider serving the records instead.
+	foreign := foreignNameservers(nameservers)
+	if len(foreign) == 0 {
+		return
+	}
+
+	addDiagnostic := diagnostics.AddAttributeWarning
+	if c.strict {
+		addDiagnostic = diagnostics.AddAttributeError
+	}
+
+	addDiagnostic(
+		attrPath,
+		"Domain Not Delegated to Porkbun",
+		fmt.Sprintf("The domain %s is delegated to %s instead of Porkbun's nameservers, so DNS records managed at Porkbun are not served. "+
+			"Point the domain at Porkbun's nameservers, e.g. using the porkbun_nameservers resource, or manage the records with the DNS provider in use.",
+			domain, strings.Join(foreign, ", ")),
+	)
+}
+
+// nameservers returns the nameservers of the domain, looking them up until a
+// lookup succeeds.
+func (c *nameserverCheck) nameservers(ctx context.Context, domain string) ([]string, error) {
+	key := dnsname.Normalize(domain)
+
+	c.mu.Lock()
+	lookup, ok := c.lookups[key]
+	if !ok {
+		lookup = &nameserverLookup{}
+		c.lookups[key] = lookup
+	}
+	c.mu.Unlock()
+
+	lookup.mu.Lock()
+	defer lookup.mu.Unlock()
+	if lookup.done {
+		return lookup.nameservers, nil
+	}
+
+	resp, err := c.client.Domains.GetNameServers(ctx, domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching nameservers for domain %s: %w", domain, err)
+	}
+	lookup.nameservers, lookup.done = resp.NS, true
+	return lookup.nameservers, nil
+}
+
+// foreignNameservers returns the nameservers that are not operated by Porkbun.
+func foreignNameservers(nameservers []string) []string {
+	var foreign []string
+	for _, ns := range nameservers {
+		if !strings.HasSuffix(dnsname.Normalize(ns), ".porkbun.com") {
+			foreign = append(foreign, dnsname.Normalize(ns))
+		}
+	}
+	return foreign
+}
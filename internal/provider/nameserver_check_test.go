@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestForeignNameservers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		nameservers []string
+		want        []string
+	}{
+		{name: "porkbun", nameservers: []string{"curitiba.ns.porkbun.com", "Fortaleza.NS.Porkbun.com."}},
+		{name: "foreign", nameservers: []string{"ns1.example.net", "NS2.example.net."}, want: []string{"ns1.example.net", "ns2.example.net"}},
+		{name: "mixed", nameservers: []string{"curitiba.ns.porkbun.com", "ns1.example.net"}, want: []string{"ns1.example.net"}},
+		{name: "lookalike", nameservers: []string{"ns.notporkbun.com"}, want: []string{"ns.notporkbun.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := foreignNameservers(tt.nameservers); !slices.Equal(got, tt.want) {
+				t.Errorf("foreignNameservers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNameserverCheck_check(t *testing.T) {
+	api := newFakeAPI()
+	api.ns["example.com"] = []string{"curitiba.ns.porkbun.com", "fortaleza.ns.porkbun.com"}
+	api.ns["example.net"] = []string{"ns1.example.org", "ns2.example.org"}
+	ctx := context.Background()
+
+	c := newNameserverCheck(newTestClient(t, api), false)
+
+	var diags diag.Diagnostics
+	c.check(ctx, "example.com", path.Root("domain"), &diags)
+	if len(diags) != 0 {
+		t.Fatalf("check() = %v, want no diagnostics for Porkbun nameservers", diags)
+	}
+
+	c.check(ctx, "example.net", path.Root("domain"), &diags)
+	c.check(ctx, "Example.NET", path.Root("domain"), &diags)
+	if diags.HasError() || diags.WarningsCount() != 2 {
+		t.Fatalf("check() = %v, want two warnings", diags)
+	}
+
+	diags = nil
+	c.check(ctx, "example.org", path.Root("domain"), &diags)
+	if len(diags) != 0 {
+		t.Fatalf("check() = %v, want no diagnostics for a domain without nameservers", diags)
+	}
+
+	if got := countCalls(api.calls, "domain/getNs"); got != 3 {
+		t.Errorf("domain/getNs called %d times, want once per domain", got)
+	}
+
+	// Failed lookups are not cached, so that a cancelled request does not
+	// fail the checks of later ones.
+	api.ns["example.info"] = []string{"ns1.example.org", "ns2.example.org"}
+	api.failures["domain/getNs"] = "unavailable"
+	diags = nil
+	c.check(ctx, "example.info", path.Root("domain"), &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 || diags[0].Summary() != "Unable to Check Nameservers" {
+		t.Fatalf("check() = %v, want a warning that the nameservers could not be checked", diags)
+	}
+	delete(api.failures, "domain/getNs")
+	diags = nil
+	c.check(ctx, "example.info", path.Root("domain"), &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 || diags[0].Summary() != "Domain Not Delegated to Porkbun" {
+		t.Fatalf("check() = %v, want a warning after retrying the lookup", diags)
+	}
+
+	strict := newNameserverCheck(newTestClient(t, api), true)
+	diags = nil
+	strict.check(ctx, "example.net", path.Root("domain"), &diags)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 0 {
+		t.Fatalf("check() = %v, want a single error in strict mode", diags)
+	}
+
+	var nilCheck *nameserverCheck
+	diags = nil
+	nilCheck.check(ctx, "example.net", path.Root("domain"), &diags)
+	if len(diags) != 0 {
+		t.Fatalf("check() = %v, want no diagnostics without a check", diags)
+	}
+}
+
+// countCalls returns the number of times the API action was called.
+func countCalls(calls []string, action string) int {
+	count := 0
+	for _, call := range calls {
+		if call == action {
+			count++
+		}
+	}
+	return count
+}
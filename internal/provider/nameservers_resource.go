@@ -3,34 +3,77 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	"github.com/tuzzmaniandevil/porkbun-go"
 
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/hostnametypes"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
 	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/hostnamevalidator"
 )
 
 var (
-	_ resource.Resource                = &DomainNameserversResource{}
-	_ resource.ResourceWithImportState = &DomainNameserversResource{}
+	_ resource.Resource                   = &DomainNameserversResource{}
+	_ resource.ResourceWithImportState    = &DomainNameserversResource{}
+	_ resource.ResourceWithValidateConfig = &DomainNameserversResource{}
+	_ resource.ResourceWithModifyPlan     = &DomainNameserversResource{}
 )
 
+const (
+	// Registries usually accept between 2 and 13 nameservers.
+	minNameservers = 2
+	maxNameservers = 13
+)
+
+// nameserversOnDestroy determines what happens to the nameservers of the domain
+// when the resource is destroyed.
+type nameserversOnDestroy string
+
+const (
+	nameserversOnDestroyRestorePrevious nameserversOnDestroy = "restore_previous"
+	nameserversOnDestroyPorkbunDefault  nameserversOnDestroy = "porkbun_default"
+	nameserversOnDestroyLeave           nameserversOnDestroy = "leave"
+)
+
+// porkbunNameservers are the nameservers Porkbun assigns to domains by default.
+var porkbunNameservers = porkbun.NameServers{
+	"curitiba.ns.porkbun.com",
+	"fortaleza.ns.porkbun.com",
+	"maceio.ns.porkbun.com",
+	"salvador.ns.porkbun.com",
+}
+
 func NewDomainNameserversResource() resource.Resource {
 	return &DomainNameserversResource{}
 }
 
 type DomainNameserversResource struct {
 	client *porkbun.Client
+	api    *porkbunapi.Client
 }
 
 type DomainNameserversResourceModel struct {
-	Domain      types.String `tfsdk:"domain"`
-	Nameservers types.List   `tfsdk:"nameservers"`
+	Domain              types.String `tfsdk:"domain"`
+	Nameservers         types.Set    `tfsdk:"nameservers"`
+	PreviousNameservers types.List   `tfsdk:"previous_nameservers"`
+	OnDestroy           types.String `tfsdk:"on_destroy"`
 }
 
 func (r *DomainNameserversResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,11 +87,42 @@ func (r *DomainNameserversResource) Schema(ctx context.Context, req resource.Sch
 			"domain": schema.StringAttribute{
 				MarkdownDescription: "The domain name to manage nameservers for. Must be a domain registered with Porkbun.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-			"nameservers": schema.ListAttribute{
-				MarkdownDescription: "A list of name server host names.",
+			"nameservers": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("A set of %d to %d name server host names. Host names are compared case-insensitively and regardless of a trailing dot. ", minNameservers, maxNameservers) +
+					"Nameservers within the domain itself, such as `ns1.example.com` for `example.com`, need glue records at the registry.",
+				ElementType: hostnametypes.HostnameType{},
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(minNameservers, maxNameservers),
+					setvalidator.ValueStringsAre(hostnamevalidator.Valid()),
+				},
+			},
+			"previous_nameservers": schema.ListAttribute{
+				MarkdownDescription: "The nameservers of the domain before the resource was created. Not set for imported resources, as the nameservers at the time of import may already be the managed ones.",
 				ElementType:         types.StringType,
-				Required:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What to do with the nameservers of the domain when the resource is destroyed. " +
+					"`restore_previous` restores `previous_nameservers`, `porkbun_default` sets Porkbun's default nameservers and `leave` keeps the managed nameservers in place. " +
+					"Defaults to `restore_previous`. If no previous nameservers were recorded, e.g. as the resource was imported, Porkbun's default nameservers are restored instead.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(nameserversOnDestroyRestorePrevious)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(nameserversOnDestroyRestorePrevious),
+						string(nameserversOnDestroyPorkbunDefault),
+						string(nameserversOnDestroyLeave),
+					),
+				},
 			},
 		},
 	}
@@ -56,6 +130,45 @@ func (r *DomainNameserversResource) Schema(ctx context.Context, req resource.Sch
 
 func (r *DomainNameserversResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+	r.api = getPorkbunAPIClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (r *DomainNameserversResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DomainNameserversResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Nameservers.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]bool)
+	for _, elem := range data.Nameservers.Elements() {
+		hostname, ok := elem.(hostnametypes.Hostname)
+		if !ok || hostname.IsNull() || hostname.IsUnknown() {
+			continue
+		}
+		if seen[hostname.Normalized()] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("nameservers").AtSetValue(elem),
+				"Duplicate Nameserver",
+				fmt.Sprintf("The nameserver %q is listed more than once.", hostname.Normalized()),
+			)
+		}
+		seen[hostname.Normalized()] = true
+	}
+}
+
+func (r *DomainNameserversResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.api == nil {
+		return
+	}
+
+	var plan DomainNameserversResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Domain.IsUnknown() || plan.Nameservers.IsUnknown() {
+		return
+	}
+
+	r.checkGlue(ctx, &plan, &resp.Diagnostics)
 }
 
 func (r *DomainNameserversResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -71,12 +184,18 @@ func (r *DomainNameserversResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
+	previous, err := r.client.Domains.GetNameServers(ctx, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Previous Nameservers", err.Error())
+		return
+	}
+	data.PreviousNameservers = nameserversToList(previous.NS)
+
 	if _, err := r.client.Domains.UpdateNameServers(ctx, data.Domain.ValueString(), &nameservers); err != nil {
 		resp.Diagnostics.AddError("Error Setting Nameservers", err.Error())
 		return
 	}
 
-	data.Nameservers = util.MustMapToList(nameservers, types.StringType, func(s string) attr.Value { return types.StringValue(s) })
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -93,7 +212,7 @@ func (r *DomainNameserversResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	data.Nameservers = util.MustMapToList(nsResp.NS, types.StringType, func(s string) attr.Value { return types.StringValue(s) })
+	data.Nameservers = nameserversToSet(nsResp.NS)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -115,7 +234,12 @@ func (r *DomainNameserversResource) Update(ctx context.Context, req resource.Upd
 		return
 	}
 
-	data.Nameservers = util.MustMapToList(nameservers, types.StringType, func(s string) attr.Value { return types.StringValue(s) })
+	// Imported resources have no previous nameservers, which leaves them
+	// unknown in the plan.
+	if data.PreviousNameservers.IsUnknown() {
+		data.PreviousNameservers = types.ListNull(types.StringType)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -126,27 +250,133 @@ func (r *DomainNameserversResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	if _, err := r.client.Domains.UpdateNameServers(ctx, data.Domain.ValueString(), &porkbun.NameServers{}); err != nil {
-		resp.Diagnostics.AddError("Error Deleting Nameservers", err.Error())
+	nameservers, ok := data.destroyNameservers()
+	if !ok {
+		return
+	}
+
+	if _, err := r.client.Domains.UpdateNameServers(ctx, data.Domain.ValueString(), &nameservers); err != nil {
+		resp.Diagnostics.AddError("Error Restoring Nameservers", err.Error())
 	}
 }
 
 func (r *DomainNameserversResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("domain"), req, resp)
+	// The nameservers at the time of import may already be the managed ones,
+	// so no previous nameservers are recorded and Porkbun's default nameservers
+	// are restored on destroy.
+	nsResp, err := r.client.Domains.GetNameServers(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Nameservers", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &DomainNameserversResourceModel{
+		Domain:              types.StringValue(req.ID),
+		Nameservers:         nameserversToSet(nsResp.NS),
+		PreviousNameservers: types.ListNull(types.StringType),
+		OnDestroy:           types.StringValue(string(nameserversOnDestroyRestorePrevious)),
+	})...)
+}
+
+// destroyNameservers returns the nameservers to set when the resource is
+// destroyed. The second return value is false if they are left unchanged.
+func (m *DomainNameserversResourceModel) destroyNameservers() (porkbun.NameServers, bool) {
+	switch nameserversOnDestroy(m.OnDestroy.ValueString()) {
+	case nameserversOnDestroyLeave:
+		return nil, false
+	case nameserversOnDestroyPorkbunDefault:
+		return porkbunNameservers, true
+	default:
+		previous, err := extractNameservers(m.PreviousNameservers)
+		if err != nil || len(previous) == 0 {
+			return porkbunNameservers, true
+		}
+		return previous, true
+	}
+}
+
+// checkGlue warns about nameservers within the domain that have no glue record,
+// without which the delegation to them cannot be resolved.
+func (r *DomainNameserversResource) checkGlue(ctx context.Context, data *DomainNameserversResourceModel, diagnostics *diag.Diagnostics) {
+	domain := data.Domain.ValueString()
+
+	var inBailiwick []attr.Value
+	for _, elem := range data.Nameservers.Elements() {
+		hostname, ok := elem.(hostnametypes.Hostname)
+		if !ok || hostname.IsUnknown() {
+			continue
+		}
+		if _, ok := dnsname.Subdomain(hostname.Normalized(), domain); ok {
+			inBailiwick = append(inBailiwick, elem)
+		}
+	}
+	if len(inBailiwick) == 0 {
+		return
+	}
+
+	glue, err := r.api.GetGlueRecords(ctx, domain)
+	if err != nil {
+		diagnostics.AddAttributeWarning(
+			path.Root("nameservers"),
+			"Error Checking for Glue Records",
+			fmt.Sprintf("error fetching glue records for domain %q: %s", domain, err),
+		)
+		return
+	}
+
+	for _, elem := range inBailiwick {
+		hostname := elem.(hostnametypes.Hostname).Normalized()
+		if !hasGlueRecord(glue, hostname) {
+			diagnostics.AddAttributeWarning(
+				path.Root("nameservers").AtSetValue(elem),
+				"Missing Glue Record",
+				fmt.Sprintf("The nameserver %s is within the domain %s, but the domain has no glue record for it. "+
+					"Resolvers cannot reach the nameserver until a glue record with its IP addresses is created, e.g. with the porkbun_glue_record resource.", hostname, domain),
+			)
+		}
+	}
+}
+
+// hasGlueRecord reports whether glue contains a record with addresses for the host.
+func hasGlueRecord(glue []porkbunapi.GlueRecord, host string) bool {
+	for _, record := range glue {
+		if dnsname.Normalize(record.Host) == host && len(record.IPv4)+len(record.IPv6) > 0 {
+			return true
+		}
+	}
+	return false
 }
 
-// extractNameservers converts a types.List to a porkbun.NameServers slice.
-func extractNameservers(list types.List) (porkbun.NameServers, error) {
-	elements := list.Elements()
+// extractNameservers converts a types.List or types.Set of strings to a porkbun.NameServers slice.
+func extractNameservers(collection interface{ Elements() []attr.Value }) (porkbun.NameServers, error) {
+	elements := collection.Elements()
 	result := make(porkbun.NameServers, 0, len(elements))
 	for _, elem := range elements {
-		stringElem, ok := elem.(types.String)
+		stringElem, ok := elem.(basetypes.StringValuable)
 		if !ok {
 			return nil, fmt.Errorf("error converting element to string: %v", elem)
 		}
-		result = append(result, stringElem.ValueString())
+		value, diags := stringElem.ToStringValue(context.Background())
+		if diags.HasError() {
+			return nil, fmt.Errorf("error converting element to string: %v", elem)
+		}
+		result = append(result, value.ValueString())
 	}
 	return result, nil
 }
 
-// listOfStringsToList converts a slice of strings to a types.List.
+// nameserversToSet converts nameservers to a types.Set of host names.
+func nameserversToSet(nameservers porkbun.NameServers) types.Set {
+	elements := make([]attr.Value, 0, len(nameservers))
+	for i, ns := range nameservers {
+		if !slices.Contains(nameservers[:i], ns) {
+			elements = append(elements, hostnametypes.NewHostnameValue(ns))
+		}
+	}
+	return types.SetValueMust(hostnametypes.HostnameType{}, elements)
+}
+
+// nameserversToList converts nameservers to a types.List.
+func nameserversToList(nameservers porkbun.NameServers) types.List {
+	return util.MustMapToList(nameservers, types.StringType, func(s string) attr.Value { return types.StringValue(s) })
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
+)
+
+func TestDomainNameserversResource_Lifecycle(t *testing.T) {
+	tests := []struct {
+		name      string
+		previous  []string
+		onDestroy nameserversOnDestroy
+		want      []string
+	}{
+		{
+			name:      "restore previous",
+			previous:  []string{"ns1.example.net", "ns2.example.net"},
+			onDestroy: nameserversOnDestroyRestorePrevious,
+			want:      []string{"ns1.example.net", "ns2.example.net"},
+		},
+		{
+			name:      "restore without previous",
+			onDestroy: nameserversOnDestroyRestorePrevious,
+			want:      porkbunNameservers,
+		},
+		{
+			name:      "porkbun default",
+			previous:  []string{"ns1.example.net", "ns2.example.net"},
+			onDestroy: nameserversOnDestroyPorkbunDefault,
+			want:      porkbunNameservers,
+		},
+		{
+			name:      "leave",
+			previous:  []string{"ns1.example.net", "ns2.example.net"},
+			onDestroy: nameserversOnDestroyLeave,
+			want:      []string{"ns1.example.org", "ns2.example.org"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI()
+			api.ns["example.com"] = tt.previous
+			r := &DomainNameserversResource{client: newTestClient(t, api)}
+			ctx := context.Background()
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			plan := DomainNameserversResourceModel{
+				Domain:              types.StringValue("example.com"),
+				Nameservers:         nameserversToSet([]string{"ns1.example.org", "ns2.example.org"}),
+				PreviousNameservers: types.ListUnknown(types.StringType),
+				OnDestroy:           types.StringValue(string(tt.onDestroy)),
+			}
+			createReq := fwresource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema}}
+			createReq.Plan.Set(ctx, &plan)
+			createResp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			r.Create(ctx, createReq, &createResp)
+			if createResp.Diagnostics.HasError() {
+				t.Fatalf("Create() unexpected error: %v", createResp.Diagnostics)
+			}
+
+			var state DomainNameserversResourceModel
+			createResp.State.Get(ctx, &state)
+			if previous, _ := extractNameservers(state.PreviousNameservers); !slices.Equal(previous, tt.previous) {
+				t.Errorf("previous_nameservers = %v, want %v", previous, tt.previous)
+			}
+
+			deleteResp := fwresource.DeleteResponse{}
+			r.Delete(ctx, fwresource.DeleteRequest{State: createResp.State}, &deleteResp)
+			if deleteResp.Diagnostics.HasError() {
+				t.Fatalf("Delete() unexpected error: %v", deleteResp.Diagnostics)
+			}
+			if got := api.ns["example.com"]; !slices.Equal(got, tt.want) {
+				t.Errorf("nameservers after Delete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDomainNameserversResource_ImportState(t *testing.T) {
+	api := newFakeAPI()
+	api.ns["example.com"] = []string{"ns1.example.org", "ns2.example.org"}
+	r := &DomainNameserversResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	importResp := fwresource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	importResp.State.Raw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: "example.com"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("ImportState() unexpected error: %v", importResp.Diagnostics)
+	}
+
+	var state DomainNameserversResourceModel
+	importResp.State.Get(ctx, &state)
+	if !state.PreviousNameservers.IsNull() {
+		t.Errorf("previous_nameservers = %v, want null", state.PreviousNameservers)
+	}
+
+	// Without previous nameservers, Porkbun's default nameservers are restored.
+	deleteResp := fwresource.DeleteResponse{}
+	r.Delete(ctx, fwresource.DeleteRequest{State: importResp.State}, &deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Fatalf("Delete() unexpected error: %v", deleteResp.Diagnostics)
+	}
+	if got := api.ns["example.com"]; !slices.Equal(got, porkbunNameservers) {
+		t.Errorf("nameservers after Delete() = %v, want %v", got, porkbunNameservers)
+	}
+}
+
+func TestDomainNameserversResource_ValidateConfig(t *testing.T) {
+	r := &DomainNameserversResource{}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name        string
+		nameservers []string
+		wantErr     bool
+	}{
+		{name: "distinct", nameservers: []string{"ns1.example.net", "ns2.example.net"}},
+		{name: "duplicate after normalization", nameservers: []string{"ns1.example.net", "NS1.example.net."}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			state.Set(ctx, &DomainNameserversResourceModel{
+				Domain:              types.StringValue("example.com"),
+				Nameservers:         nameserversToSet(tt.nameservers),
+				PreviousNameservers: types.ListNull(types.StringType),
+				OnDestroy:           types.StringNull(),
+			})
+
+			resp := fwresource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}, &resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateConfig() = %v, wantErr %t", resp.Diagnostics, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDomainNameserversResource_checkGlue(t *testing.T) {
+	api := newFakeAPI()
+	api.glue["example.com"] = []porkbunapi.GlueRecord{{Host: "ns1.example.com", IPv4: []string{"192.0.2.1"}}}
+	r := &DomainNameserversResource{api: newTestAPIClient(t, api)}
+
+	model := DomainNameserversResourceModel{
+		Domain:      types.StringValue("example.com"),
+		Nameservers: nameserversToSet([]string{"NS1.example.com.", "ns2.example.com", "ns.example.net"}),
+	}
+
+	var diags diag.Diagnostics
+	r.checkGlue(context.Background(), &model, &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("checkGlue() = %v, want a single warning", diags)
+	}
+	if got := diags.Warnings()[0].Detail(); !strings.Contains(got, "ns2.example.com") {
+		t.Errorf("checkGlue() warning = %q, want it to name ns2.example.com", got)
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+var _ function.Function = &ParseDKIMFunction{}
+
+// parseDKIMAttrTypes defines the attributes of the object returned by ParseDKIMFunction.
+var parseDKIMAttrTypes = map[string]attr.Type{
+	"key_type":        types.StringType,
+	"public_key":      types.StringType,
+	"hash_algorithms": types.ListType{ElemType: types.StringType},
+	"service_types":   types.ListType{ElemType: types.StringType},
+	"flags":           types.ListType{ElemType: types.StringType},
+	"notes":           types.StringType,
+}
+
+func NewParseDKIMFunction() function.Function {
+	return &ParseDKIMFunction{}
+}
+
+// ParseDKIMFunction parses the value of a DKIM key TXT record.
+type ParseDKIMFunction struct{}
+
+func (f *ParseDKIMFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_dkim"
+}
+
+func (f *ParseDKIMFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a DKIM record value",
+		MarkdownDescription: "Parses the value of a DKIM key TXT record into an object with the attributes " +
+			"`key_type` (`k` tag, defaults to `rsa`), `public_key` (`p` tag, empty if the key was revoked), " +
+			"`hash_algorithms` (`h` tag), `service_types` (`s` tag), `flags` (`t` tag) and `notes` (`n` tag). " +
+			"Tags missing from the record are null. Quoted and folded values as returned by DNS tools are accepted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "record",
+				MarkdownDescription: "The DKIM record value, for example `v=DKIM1; k=rsa; p=MIIB...`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseDKIMAttrTypes,
+		},
+	}
+}
+
+func (f *ParseDKIMFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &record))
+	if resp.Error != nil {
+		return
+	}
+
+	key, err := mailauth.ParseDKIM(record)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid DKIM record: %s", err))
+		return
+	}
+
+	result := types.ObjectValueMust(parseDKIMAttrTypes, map[string]attr.Value{
+		"key_type":        types.StringValue(key.KeyType),
+		"public_key":      types.StringValue(key.PublicKey),
+		"hash_algorithms": util.StringsToList(key.HashAlgorithms),
+		"service_types":   util.StringsToList(key.ServiceTypes),
+		"flags":           util.StringsToList(key.Flags),
+		"notes":           util.StringOrNull(key.Notes),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDKIMFunction(t *testing.T) {
+	got, funcErr := runFunction(t, NewParseDKIMFunction(), types.StringValue("v=DKIM1; t=s; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQ=="))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+
+	want := types.ObjectValueMust(parseDKIMAttrTypes, map[string]attr.Value{
+		"key_type":        types.StringValue("rsa"),
+		"public_key":      types.StringValue("MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQ=="),
+		"hash_algorithms": types.ListNull(types.StringType),
+		"service_types":   types.ListNull(types.StringType),
+		"flags":           types.ListValueMust(types.StringType, []attr.Value{types.StringValue("s")}),
+		"notes":           types.StringNull(),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+
+	_, funcErr = runFunction(t, NewParseDKIMFunction(), types.StringValue("v=DKIM1; k=rsa"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "missing the required p tag") {
+		t.Errorf("Run() error = %v, want invalid record", funcErr)
+	}
+}
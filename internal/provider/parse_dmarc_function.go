@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+var _ function.Function = &ParseDMARCFunction{}
+
+// parseDMARCAttrTypes defines the attributes of the object returned by ParseDMARCFunction.
+var parseDMARCAttrTypes = map[string]attr.Type{
+	"p":     types.StringType,
+	"sp":    types.StringType,
+	"pct":   types.Int64Type,
+	"rua":   types.ListType{ElemType: types.StringType},
+	"ruf":   types.ListType{ElemType: types.StringType},
+	"adkim": types.StringType,
+	"aspf":  types.StringType,
+	"fo":    types.StringType,
+}
+
+func NewParseDMARCFunction() function.Function {
+	return &ParseDMARCFunction{}
+}
+
+// ParseDMARCFunction parses the value of a DMARC TXT record.
+type ParseDMARCFunction struct{}
+
+func (f *ParseDMARCFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_dmarc"
+}
+
+func (f *ParseDMARCFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a DMARC record value",
+		MarkdownDescription: "Parses the value of a DMARC TXT record into an object with the same attributes accepted by the `dmarc` function. " +
+			"Tags missing from the record are null. Quoted values as returned by DNS tools are accepted.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "record",
+				MarkdownDescription: "The DMARC record value, for example `v=DMARC1; p=reject; rua=mailto:dmarc@example.com`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseDMARCAttrTypes,
+		},
+	}
+}
+
+func (f *ParseDMARCFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &record))
+	if resp.Error != nil {
+		return
+	}
+
+	dmarc, err := mailauth.ParseDMARC(record)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid DMARC record: %s", err))
+		return
+	}
+
+	result := types.ObjectValueMust(parseDMARCAttrTypes, map[string]attr.Value{
+		"p":     types.StringValue(dmarc.Policy),
+		"sp":    util.StringOrNull(dmarc.SubdomainPolicy),
+		"pct":   types.Int64PointerValue(dmarc.Percent),
+		"rua":   util.StringsToList(dmarc.RUA),
+		"ruf":   util.StringsToList(dmarc.RUF),
+		"adkim": util.StringOrNull(dmarc.ADKIM),
+		"aspf":  util.StringOrNull(dmarc.ASPF),
+		"fo":    util.StringOrNull(dmarc.FailureOptions),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
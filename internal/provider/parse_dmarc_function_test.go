@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDMARCFunction(t *testing.T) {
+	got, funcErr := runFunction(t, NewParseDMARCFunction(), types.StringValue("v=DMARC1; p=reject; pct=100; rua=mailto:a@example.com,mailto:b@example.com"))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+
+	want := types.ObjectValueMust(parseDMARCAttrTypes, map[string]attr.Value{
+		"p":     types.StringValue("reject"),
+		"sp":    types.StringNull(),
+		"pct":   types.Int64Value(100),
+		"rua":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("mailto:a@example.com"), types.StringValue("mailto:b@example.com")}),
+		"ruf":   types.ListNull(types.StringType),
+		"adkim": types.StringNull(),
+		"aspf":  types.StringNull(),
+		"fo":    types.StringNull(),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+
+	_, funcErr = runFunction(t, NewParseDMARCFunction(), types.StringValue("v=spf1 -all"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "must start with v=DMARC1") {
+		t.Errorf("Run() error = %v, want invalid record", funcErr)
+	}
+}
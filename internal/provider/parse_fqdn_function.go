@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+var _ function.Function = &ParseFQDNFunction{}
+
+// parseFQDNAttrTypes defines the attributes of the object returned by ParseFQDNFunction.
+var parseFQDNAttrTypes = map[string]attr.Type{
+	"subdomain": types.StringType,
+	"domain":    types.StringType,
+	"tld":       types.StringType,
+	"wildcard":  types.BoolType,
+}
+
+func NewParseFQDNFunction() function.Function {
+	return &ParseFQDNFunction{}
+}
+
+// ParseFQDNFunction splits a fully-qualified domain name at its registrable domain.
+type ParseFQDNFunction struct{}
+
+func (f *ParseFQDNFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_fqdn"
+}
+
+func (f *ParseFQDNFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a fully-qualified domain name",
+		MarkdownDescription: "Splits a fully-qualified domain name into an object with the attributes " +
+			"`subdomain` (empty for the domain itself), `domain` (the registrable domain), `tld` (the public suffix) " +
+			"and `wildcard` (whether the first label is `*`). " +
+			"The registrable domain is determined using the [Public Suffix List](https://publicsuffix.org/), " +
+			"so `www.example.co.uk` is split into `www` and `example.co.uk`. " +
+			"Internationalized names are converted to their ASCII form, and all parts are returned in lower case.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "fqdn",
+				MarkdownDescription: "The fully-qualified domain name, for example `www.example.com`. A trailing dot is ignored.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseFQDNAttrTypes,
+		},
+	}
+}
+
+func (f *ParseFQDNFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var fqdn string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &fqdn))
+	if resp.Error != nil {
+		return
+	}
+
+	name, err := dnsname.Parse(fqdn)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid domain name: %s", err))
+		return
+	}
+
+	result := types.ObjectValueMust(parseFQDNAttrTypes, map[string]attr.Value{
+		"subdomain": types.StringValue(name.Subdomain),
+		"domain":    types.StringValue(name.Domain),
+		"tld":       types.StringValue(name.TLD),
+		"wildcard":  types.BoolValue(name.Wildcard),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseFQDNFunction(t *testing.T) {
+	got, funcErr := runFunction(t, NewParseFQDNFunction(), types.StringValue("*.API.example.co.uk."))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+
+	want := types.ObjectValueMust(parseFQDNAttrTypes, map[string]attr.Value{
+		"subdomain": types.StringValue("*.api"),
+		"domain":    types.StringValue("example.co.uk"),
+		"tld":       types.StringValue("co.uk"),
+		"wildcard":  types.BoolValue(true),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+
+	_, funcErr = runFunction(t, NewParseFQDNFunction(), types.StringValue("co.uk"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "public suffix") {
+		t.Errorf("Run() error = %v, want public suffix error", funcErr)
+	}
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/zonefile"
+)
+
+var _ function.Function = &ParseZoneFileFunction{}
+
+// zoneRecordAttrTypes defines the attributes of the records returned by ParseZoneFileFunction.
+// They match the arguments of porkbun_dns_record.
+var zoneRecordAttrTypes = map[string]attr.Type{
+	"subdomain": types.StringType,
+	"type":      types.StringType,
+	"content":   types.StringType,
+	"ttl":       types.Int64Type,
+	"prio":      types.Int64Type,
+}
+
+func NewParseZoneFileFunction() function.Function {
+	return &ParseZoneFileFunction{}
+}
+
+// ParseZoneFileFunction parses a master file into DNS records.
+type ParseZoneFileFunction struct{}
+
+func (f *ParseZoneFileFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_zone_file"
+}
+
+func (f *ParseZoneFileFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a zone file into DNS records",
+		MarkdownDescription: "Parses an RFC 1035 master (zone) file into a list of objects with the attributes " +
+			"`subdomain`, `type`, `content`, `ttl` and `prio`, matching the arguments of `porkbun_dns_record`. " +
+			"`$ORIGIN` and `$TTL` directives, relative names, parentheses spanning multiple lines and TXT records " +
+			"consisting of multiple strings are supported; `$INCLUDE` is not. " +
+			"The priority of MX and SRV records is returned in `prio`, and TTLs below Porkbun's minimum of 600 seconds are raised to it. " +
+			"SOA records and NS records of the domain itself are skipped, as they are managed by Porkbun. " +
+			"Records of types not supported by Porkbun and records outside the domain cause an error.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "text",
+				MarkdownDescription: "The content of the zone file.",
+			},
+			function.StringParameter{
+				Name:                "origin",
+				MarkdownDescription: "The domain the zone file belongs to, for example `example.com`. It is the initial `$ORIGIN`, and subdomains are returned relative to it.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: zoneRecordAttrTypes},
+		},
+	}
+}
+
+func (f *ParseZoneFileFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text, origin string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &text, &origin))
+	if resp.Error != nil {
+		return
+	}
+
+	records, err := zonefile.Parse(text, origin)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid zone file: %s", err))
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(records))
+	for _, record := range records {
+		elems = append(elems, types.ObjectValueMust(zoneRecordAttrTypes, map[string]attr.Value{
+			"subdomain": types.StringValue(record.Subdomain),
+			"type":      types.StringValue(record.Type),
+			"content":   types.StringValue(record.Content),
+			"ttl":       types.Int64Value(record.TTL),
+			"prio":      types.Int64Value(record.Prio),
+		}))
+	}
+	result := types.ListValueMust(types.ObjectType{AttrTypes: zoneRecordAttrTypes}, elems)
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
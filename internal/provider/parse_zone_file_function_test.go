@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseZoneFileFunction(t *testing.T) {
+	const zone = "$TTL 3600\n@ IN MX 10 mail\nwww IN TXT \"hello\" \" world\"\n"
+
+	got, funcErr := runFunction(t, NewParseZoneFileFunction(), types.StringValue(zone), types.StringValue("example.com"))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+
+	want := types.ListValueMust(types.ObjectType{AttrTypes: zoneRecordAttrTypes}, []attr.Value{
+		types.ObjectValueMust(zoneRecordAttrTypes, map[string]attr.Value{
+			"subdomain": types.StringValue(""),
+			"type":      types.StringValue("MX"),
+			"content":   types.StringValue("mail.example.com"),
+			"ttl":       types.Int64Value(3600),
+			"prio":      types.Int64Value(10),
+		}),
+		types.ObjectValueMust(zoneRecordAttrTypes, map[string]attr.Value{
+			"subdomain": types.StringValue("www"),
+			"type":      types.StringValue("TXT"),
+			"content":   types.StringValue("hello world"),
+			"ttl":       types.Int64Value(3600),
+			"prio":      types.Int64Value(0),
+		}),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+
+	_, funcErr = runFunction(t, NewParseZoneFileFunction(), types.StringValue("@ IN PTR host.example.com.\n"), types.StringValue("example.com"))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "not supported by Porkbun") {
+		t.Errorf("Run() error = %v, want unsupported type", funcErr)
+	}
+}
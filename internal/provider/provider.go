@@ -18,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/porkbunapi"
 )
 
 const (
@@ -29,9 +31,13 @@ const (
 	argIPv4Only     = "ipv4_only"
 	argMaxRetries   = "max_retries"
 
+	argRequirePorkbunNameservers = "require_porkbun_nameservers"
+
 	// Default values for provider arguments.
 	argIPV4OnlyDefault   = false
 	argMaxRetriesDefault = 3
+
+	argRequirePorkbunNameserversDefault = false
 )
 
 // Ensure PorkbunProvider satisfies various provider interfaces.
@@ -52,6 +58,8 @@ type PorkbunProviderModel struct {
 	SecretAPIKey types.String `tfsdk:"secret_api_key"`
 	IPv4Only     types.Bool   `tfsdk:"ipv4_only"`
 	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+
+	RequirePorkbunNameservers types.Bool `tfsdk:"require_porkbun_nameservers"`
 }
 
 func (p *PorkbunProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -81,6 +89,10 @@ func (p *PorkbunProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				MarkdownDescription: fmt.Sprintf("Maximum number of retries for API requests. Defaults to %d.", argMaxRetriesDefault),
 				Optional:            true,
 			},
+			argRequirePorkbunNameservers: schema.BoolAttribute{
+				MarkdownDescription: "Fail the plan instead of warning when DNS records are planned for a domain that is not delegated to Porkbun's nameservers. Defaults to false.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -97,6 +109,7 @@ func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureR
 	p.validateUnknownAttribute(resp, data.SecretAPIKey, path.Root(argSecretAPIKey), "Porkbun Secret API Key")
 	p.validateUnknownAttribute(resp, data.IPv4Only, path.Root(argIPv4Only), "Porkbun IPv4 Flag")
 	p.validateUnknownAttribute(resp, data.MaxRetries, path.Root(argMaxRetries), "Max Retries Count")
+	p.validateUnknownAttribute(resp, data.RequirePorkbunNameservers, path.Root(argRequirePorkbunNameservers), "Porkbun Nameservers Flag")
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -130,6 +143,11 @@ func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureR
 		}
 	}
 
+	requirePorkbunNameservers := argRequirePorkbunNameserversDefault
+	if !data.RequirePorkbunNameservers.IsNull() {
+		requirePorkbunNameservers = data.RequirePorkbunNameservers.ValueBool()
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -142,16 +160,33 @@ func (p *PorkbunProvider) Configure(ctx context.Context, req provider.ConfigureR
 		HttpClient:   &httpClient,
 	})
 
-	resp.ResourceData = client
-	resp.EphemeralResourceData = client
-	resp.DataSourceData = client
+	providerData := &porkbunProviderData{
+		client: client,
+		api: porkbunapi.NewClient(&porkbunapi.Options{
+			HTTPClient:   httpClient,
+			APIKey:       apiKey,
+			SecretAPIKey: secretAPIKey,
+			IPv4Only:     ipv4Only,
+		}),
+		nameserverCheck: newNameserverCheck(client, requirePorkbunNameservers),
+	}
+
+	resp.ResourceData = providerData
+	resp.EphemeralResourceData = providerData
+	resp.DataSourceData = providerData
 }
 
 func (p *PorkbunProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewDNSRecordResource,
+		NewDNSSECDSSetResource,
 		NewDNSSECRecordResource,
 		NewDomainNameserversResource,
+		NewDomainRegistrationResource,
+		NewDomainResource,
+		NewEmailRecordsResource,
+		NewGlueRecordResource,
+		NewHostingRecordsResource,
 		NewURLForwardResource,
 	}
 }
@@ -164,15 +199,34 @@ func (p *PorkbunProvider) EphemeralResources(ctx context.Context) []func() ephem
 
 func (p *PorkbunProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewDelegationCheckDataSource,
+		NewDNSSECRecordsDataSource,
 		NewDomainDataSource,
 		NewDomainsDataSource,
+		NewGlueRecordsDataSource,
 		NewNameserversDataSource,
 		NewSSLDataSource,
+		NewURLForwardsDataSource,
 	}
 }
 
 func (p *PorkbunProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewCAAFunction,
+		NewDKIMFunction,
+		NewDMARCFunction,
+		NewDNSSECDSFunction,
+		NewFQDNFunction,
+		NewParseDKIMFunction,
+		NewParseDMARCFunction,
+		NewParseFQDNFunction,
+		NewParseZoneFileFunction,
+		NewSPFFunction,
+		NewTLSAFunction,
+		NewToASCIIFunction,
+		NewToUnicodeFunction,
+		NewValidateRecordFunction,
+	}
 }
 
 // validateUnknownAttribute checks if the attribute is unknown and adds an error to the response.
@@ -208,25 +262,62 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-// getPorkbunClient retrieves the Porkbun client from the provider data.
+// porkbunProviderData is passed to resources, data sources and ephemeral resources.
+type porkbunProviderData struct {
+	client *porkbun.Client
+	// api calls the endpoints not covered by client.
+	api *porkbunapi.Client
+	// nameserverCheck is shared so that each domain is only looked up once per run.
+	nameserverCheck *nameserverCheck
+}
+
+// getProviderData retrieves the provider data passed to Configure.
 //
 // It returns nil if the provider data is nil or if the type assertion fails.
 // In case of an error, it adds an error to the diagnostics.
-func getPorkbunClient(providerData any, diagnostics diag.Diagnostics) *porkbun.Client {
+func getProviderData(providerData any, diagnostics diag.Diagnostics) *porkbunProviderData {
 	if providerData == nil {
 		return nil
 	}
 
-	client, ok := providerData.(*porkbun.Client)
+	data, ok := providerData.(*porkbunProviderData)
 	if !ok {
 		diagnostics.AddError(
 			"Unexpected ProviderData",
-			fmt.Sprintf("Expected *porkbun.Client, got: %T. Please report this issue to the provider developers.", providerData),
+			fmt.Sprintf("Expected *porkbunProviderData, got: %T. Please report this issue to the provider developers.", providerData),
 		)
 		return nil
 	}
 
-	return client
+	return data
+}
+
+// getPorkbunClient retrieves the Porkbun client from the provider data.
+//
+// It returns nil if the provider data is nil or if the type assertion fails.
+// In case of an error, it adds an error to the diagnostics.
+func getPorkbunClient(providerData any, diagnostics diag.Diagnostics) *porkbun.Client {
+	if data := getProviderData(providerData, diagnostics); data != nil {
+		return data.client
+	}
+	return nil
+}
+
+// getPorkbunAPIClient retrieves the client for endpoints not covered by the
+// Porkbun client from the provider data.
+func getPorkbunAPIClient(providerData any, diagnostics diag.Diagnostics) *porkbunapi.Client {
+	if data := getProviderData(providerData, diagnostics); data != nil {
+		return data.api
+	}
+	return nil
+}
+
+// getNameserverCheck retrieves the shared nameserver check from the provider data.
+func getNameserverCheck(providerData any, diagnostics diag.Diagnostics) *nameserverCheck {
+	if data := getProviderData(providerData, diagnostics); data != nil {
+		return data.nameserverCheck
+	}
+	return nil
 }
 
 // newRetryableHttpClient creates a porkbun.HTTPClient with retry capabilities.
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/mailauth"
+)
+
+var _ function.Function = &SPFFunction{}
+
+func NewSPFFunction() function.Function {
+	return &SPFFunction{}
+}
+
+// SPFFunction builds and validates the value of an SPF TXT record.
+type SPFFunction struct{}
+
+func (f *SPFFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "spf"
+}
+
+func (f *SPFFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build an SPF record value",
+		MarkdownDescription: "Builds the value of an SPF TXT record (RFC 7208) from structured input. " +
+			"The syntax of every term is validated, and multiple `all` terms or `redirect` modifiers are rejected. " +
+			"Records with more than 10 terms causing DNS lookups are rejected, as receivers fail to evaluate them. " +
+			"This is an error rather than a warning, as provider functions cannot emit warnings. " +
+			"Lookups caused by included records are not counted.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "config",
+				MarkdownDescription: "An object with any of the following attributes: " +
+					"`a` and `mx` (bool) add the mechanisms for the domain itself; " +
+					"`ip4` and `ip6` (list of string) list addresses or CIDR ranges; " +
+					"`include` (list of string) lists domains whose SPF policy is included; " +
+					"`terms` (list of string) lists additional mechanisms and modifiers, such as `exists:%{i}._spf.example.com`; " +
+					"`redirect` (string) is the domain for the `redirect` modifier; " +
+					"`all` (string) is the qualified `all` mechanism terminating the record, such as `~all`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *SPFFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &config))
+	if resp.Error != nil {
+		return
+	}
+
+	spf, funcErr := spfFromArgument(config)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+
+	if err := spf.Validate(); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid SPF record: %s", err))
+		return
+	}
+	// Exceeding the lookup limit only deserves a warning, but functions cannot
+	// return warnings and the record would fail to evaluate, so it is rejected.
+	if lookups := spf.Lookups(); lookups > mailauth.MaxSPFLookups {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid SPF record: %d terms cause DNS lookups, which exceeds the limit of %d. "+
+			"Receivers fail to evaluate the record; replace includes with ip4 or ip6 ranges, or merge them.", lookups, mailauth.MaxSPFLookups))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, spf.String()))
+}
+
+// spfFromArgument converts the config argument to an SPF record.
+func spfFromArgument(config types.Dynamic) (mailauth.SPF, *function.FuncError) {
+	args, funcErr := newObjectArgument(config, 0, "a", "mx", "ip4", "ip6", "include", "terms", "redirect", "all")
+	if funcErr != nil {
+		return mailauth.SPF{}, funcErr
+	}
+
+	var spf mailauth.SPF
+	var errs [8]*function.FuncError
+	spf.A, errs[0] = args.Bool("a")
+	spf.MX, errs[1] = args.Bool("mx")
+	spf.IP4, errs[2] = args.Strings("ip4")
+	spf.IP6, errs[3] = args.Strings("ip6")
+	spf.Includes, errs[4] = args.Strings("include")
+	spf.Terms, errs[5] = args.Strings("terms")
+	spf.Redirect, errs[6] = args.String("redirect")
+	spf.All, errs[7] = args.String("all")
+
+	return spf, function.ConcatFuncErrors(errs[:]...)
+}
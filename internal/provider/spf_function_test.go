@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSPFFunction(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]attr.Value
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "empty",
+			config: map[string]attr.Value{},
+			want:   "v=spf1",
+		},
+		{
+			name: "full",
+			config: map[string]attr.Value{
+				"mx":      types.BoolValue(true),
+				"ip4":     stringTuple("192.0.2.0/24"),
+				"ip6":     stringTuple("2001:db8::/32"),
+				"include": stringTuple("_spf.google.com"),
+				"terms":   stringTuple("exists:%{i}._spf.example.com"),
+				"all":     types.StringValue("-all"),
+			},
+			want: "v=spf1 mx ip4:192.0.2.0/24 ip6:2001:db8::/32 include:_spf.google.com exists:%{i}._spf.example.com -all",
+		},
+		{
+			name: "redirect",
+			config: map[string]attr.Value{
+				"redirect": types.StringValue("_spf.example.com"),
+			},
+			want: "v=spf1 redirect=_spf.example.com",
+		},
+		{
+			name: "multiple all terms",
+			config: map[string]attr.Value{
+				"terms": stringTuple("~all"),
+				"all":   types.StringValue("-all"),
+			},
+			wantErr: "found 2 all terms",
+		},
+		{
+			name: "lookup limit",
+			config: map[string]attr.Value{
+				"a":       types.BoolValue(true),
+				"mx":      types.BoolValue(true),
+				"include": stringTuple("a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com"),
+				"terms":   stringTuple("a:a.example.net", "a:b.example.net", "exists:%{i}._spf.example.com"),
+				"all":     types.StringValue("-all"),
+			},
+			want: "v=spf1 a mx include:a.example.com include:b.example.com include:c.example.com include:d.example.com include:e.example.com a:a.example.net a:b.example.net exists:%{i}._spf.example.com -all",
+		},
+		{
+			name: "too many lookups",
+			config: map[string]attr.Value{
+				"a":       types.BoolValue(true),
+				"mx":      types.BoolValue(true),
+				"include": stringTuple("a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com"),
+				"terms":   stringTuple("a:a.example.net", "a:b.example.net", "mx:mail.example.net", "exists:%{i}._spf.example.com"),
+				"all":     types.StringValue("-all"),
+			},
+			wantErr: "11 terms cause DNS lookups, which exceeds the limit of 10",
+		},
+		{
+			name: "invalid address",
+			config: map[string]attr.Value{
+				"ip4": stringTuple("192.0.2.256"),
+			},
+			wantErr: `invalid IP address "192.0.2.256"`,
+		},
+		{
+			name: "wrong type",
+			config: map[string]attr.Value{
+				"include": types.StringValue("_spf.google.com"),
+			},
+			wantErr: `Invalid "include": expected a list of strings.`,
+		},
+		{
+			name: "unsupported attribute",
+			config: map[string]attr.Value{
+				"includes": stringTuple("_spf.google.com"),
+			},
+			wantErr: "Unsupported attributes includes.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, funcErr := runFunction(t, NewSPFFunction(), dynamicObject(tt.config))
+			if tt.wantErr != "" {
+				if funcErr == nil || !strings.Contains(funcErr.Text, tt.wantErr) {
+					t.Fatalf("Run() error = %v, want error containing %q", funcErr, tt.wantErr)
+				}
+				return
+			}
+			if funcErr != nil {
+				t.Fatalf("Run() unexpected error: %v", funcErr)
+			}
+			if !got.Equal(types.StringValue(tt.want)) {
+				t.Errorf("Run() = %v, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dane"
+)
+
+var _ function.Function = &TLSAFunction{}
+
+func NewTLSAFunction() function.Function {
+	return &TLSAFunction{}
+}
+
+// TLSAFunction builds the content of a DANE TLSA record.
+type TLSAFunction struct{}
+
+func (f *TLSAFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "tlsa"
+}
+
+func (f *TLSAFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a TLSA record value",
+		MarkdownDescription: "Builds the content of a DANE TLSA record (RFC 6698) from a PEM-encoded certificate or public key, " +
+			"such as `3 1 1 0c72ac70...`, for use with a `porkbun_dns_record` of type `TLSA`. " +
+			"If the input contains several certificates, the first one is used for the end-entity usages `1` and `3`, " +
+			"and the last one for the trust anchor usages `0` and `2`, so the `certificate_chain` of the `porkbun_ssl` data source can be passed as is. " +
+			"A public key can only be used with selector `1`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "certificate_pem",
+				MarkdownDescription: "The PEM-encoded certificate, certificate chain or public key.",
+			},
+			function.Int64Parameter{
+				Name:                "usage",
+				MarkdownDescription: "The certificate usage: `0` (PKIX-TA), `1` (PKIX-EE), `2` (DANE-TA) or `3` (DANE-EE).",
+			},
+			function.Int64Parameter{
+				Name:                "selector",
+				MarkdownDescription: "The selector: `0` (full certificate) or `1` (SubjectPublicKeyInfo).",
+			},
+			function.Int64Parameter{
+				Name:                "matching_type",
+				MarkdownDescription: "The matching type: `0` (exact match), `1` (SHA-256) or `2` (SHA-512).",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *TLSAFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var certificatePEM string
+	var usage, selector, matchingType int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &certificatePEM, &usage, &selector, &matchingType))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(
+		checkUintArgument(1, "usage", usage, 8),
+		checkUintArgument(2, "selector", selector, 8),
+		checkUintArgument(3, "matching_type", matchingType, 8),
+	)
+	if resp.Error != nil {
+		return
+	}
+
+	content, err := dane.TLSA(certificatePEM, uint8(usage), uint8(selector), uint8(matchingType))
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Invalid TLSA parameters: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, content))
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTLSAFunction(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+
+	got, funcErr := runFunction(t, NewTLSAFunction(), types.StringValue(keyPEM), types.Int64Value(3), types.Int64Value(1), types.Int64Value(1))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+	sum := sha256.Sum256(der)
+	if want := "3 1 1 " + hex.EncodeToString(sum[:]); !got.Equal(types.StringValue(want)) {
+		t.Errorf("Run() = %v, want %q", got, want)
+	}
+
+	_, funcErr = runFunction(t, NewTLSAFunction(), types.StringValue(keyPEM), types.Int64Value(3), types.Int64Value(0), types.Int64Value(1))
+	if funcErr == nil || !strings.Contains(funcErr.Text, "selector 1") {
+		t.Errorf("Run() error = %v, want selector error", funcErr)
+	}
+}
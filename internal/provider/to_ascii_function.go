@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+var _ function.Function = &ToASCIIFunction{}
+
+func NewToASCIIFunction() function.Function {
+	return &ToASCIIFunction{}
+}
+
+// ToASCIIFunction converts an internationalized domain name to its ASCII form.
+type ToASCIIFunction struct{}
+
+func (f *ToASCIIFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_ascii"
+}
+
+func (f *ToASCIIFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a domain name to ASCII",
+		MarkdownDescription: "Converts an internationalized domain name to its ASCII (Punycode) form as used in DNS, " +
+			"for example `bücher.example` to `xn--bcher-kva.example`. " +
+			"Names that are already in ASCII form are returned in lower case without a trailing dot. " +
+			"Underscores and wildcard labels are allowed.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The domain name to convert.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ToASCIIFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	ascii, err := dnsname.ToASCII(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid domain name: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ascii))
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToASCIIFunction(t *testing.T) {
+	got, funcErr := runFunction(t, NewToASCIIFunction(), types.StringValue("Bücher.example."))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+	if want := "xn--bcher-kva.example"; !got.Equal(types.StringValue(want)) {
+		t.Errorf("Run() = %v, want %q", got, want)
+	}
+
+	if _, funcErr = runFunction(t, NewToASCIIFunction(), types.StringValue("foo..example")); funcErr == nil {
+		t.Error("Run() expected an error for an empty label")
+	}
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+var _ function.Function = &ToUnicodeFunction{}
+
+func NewToUnicodeFunction() function.Function {
+	return &ToUnicodeFunction{}
+}
+
+// ToUnicodeFunction converts a domain name in ASCII form to its Unicode form.
+type ToUnicodeFunction struct{}
+
+func (f *ToUnicodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "to_unicode"
+}
+
+func (f *ToUnicodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert a domain name to Unicode",
+		MarkdownDescription: "Converts a domain name in ASCII (Punycode) form to its Unicode form for display, " +
+			"for example `xn--bcher-kva.example` to `bücher.example`. " +
+			"The name is validated and returned in lower case without a trailing dot.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The domain name to convert.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ToUnicodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	unicode, err := dnsname.ToUnicode(name)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid domain name: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, unicode))
+}
@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestToUnicodeFunction(t *testing.T) {
+	got, funcErr := runFunction(t, NewToUnicodeFunction(), types.StringValue("www.XN--BCHER-KVA.example"))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+	if want := "www.bücher.example"; !got.Equal(types.StringValue(want)) {
+		t.Errorf("Run() = %v, want %q", got, want)
+	}
+
+	if _, funcErr = runFunction(t, NewToUnicodeFunction(), types.StringValue("xn--invalid-.example")); funcErr == nil {
+		t.Error("Run() expected an error for invalid Punycode")
+	}
+}
@@ -5,23 +5,28 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/tuzzmaniandevil/porkbun-go"
 
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
 	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
 	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/enumvalidator"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/urlvalidator"
 )
 
 var (
 	_ resource.Resource                = &URLForwardResource{}
 	_ resource.ResourceWithImportState = &URLForwardResource{}
+	_ resource.ResourceWithModifyPlan  = &URLForwardResource{}
 )
 
 func NewURLForwardResource() resource.Resource {
@@ -40,6 +45,7 @@ type URLForwardResourceModel struct {
 	Type        types.String `tfsdk:"type"`
 	IncludePath types.Bool   `tfsdk:"include_path"`
 	Wildcard    types.Bool   `tfsdk:"wildcard"`
+	Strict      types.Bool   `tfsdk:"strict"`
 }
 
 func (r *URLForwardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,7 +57,7 @@ func (r *URLForwardResource) Schema(ctx context.Context, req resource.SchemaRequ
 		MarkdownDescription: "Manage URL forwarding rules for domains registered through Porkbun.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the URL forward. Automatically generated by Porkbun.",
+				MarkdownDescription: "The ID of the URL forward. Automatically generated by Porkbun. A new ID is assigned whenever the forward is updated.",
 				Computed:            true,
 			},
 			"domain": schema.StringAttribute{
@@ -63,16 +69,18 @@ func (r *URLForwardResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"subdomain": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "A subdomain that you would like to add URL forwarding for. Leave this blank to forward the root domain.",
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "A subdomain that you would like to add URL forwarding for. Leave this blank or set it to an empty string to forward the root domain.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"location": schema.StringAttribute{
 				Required:            true,
-				MarkdownDescription: "Where you'd like to forward the domain to.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+				MarkdownDescription: "Where you'd like to forward the domain to. Must be an absolute `http` or `https` URL.",
+				Validators: []validator.String{
+					urlvalidator.HTTP(),
 				},
 			},
 			"type": schema.StringAttribute{
@@ -81,23 +89,18 @@ func (r *URLForwardResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Validators: []validator.String{
 					enumvalidator.Valid(porkbun.Temporary, porkbun.Permanent),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"include_path": schema.BoolAttribute{
 				Required:            true,
 				MarkdownDescription: "Whether or not to include the URI path in the redirection.",
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
 			},
 			"wildcard": schema.BoolAttribute{
 				Required:            true,
 				MarkdownDescription: "Also forward all subdomains of the domain.",
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
+			},
+			"strict": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fail the plan instead of warning when existing DNS records or other URL forwards conflict with the forward. Porkbun serves forwards from its own records, so address or CNAME records at the forwarded host prevent the forward from working. Conflicts are checked when the forward is created or its host changes, and Porkbun's default parking records are ignored.",
 			},
 		},
 	}
@@ -107,6 +110,35 @@ func (r *URLForwardResource) Configure(ctx context.Context, req resource.Configu
 	r.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
 }
 
+func (r *URLForwardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan URLForwardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Domain.IsUnknown() || plan.Subdomain.IsUnknown() || plan.Wildcard.IsUnknown() {
+		return
+	}
+
+	// Existing forwards are only checked if the forwarded host changes, so that
+	// records added later do not fail every plan.
+	ownedForwardID := ""
+	if !req.State.Raw.IsNull() {
+		var state URLForwardResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if state.Domain.Equal(plan.Domain) && state.Subdomain.Equal(plan.Subdomain) && state.Wildcard.Equal(plan.Wildcard) {
+			return
+		}
+		ownedForwardID = state.ID.ValueString()
+	}
+
+	r.checkConflicts(ctx, &plan, ownedForwardID, &resp.Diagnostics)
+}
+
 func (r *URLForwardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data URLForwardResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -131,7 +163,7 @@ func (r *URLForwardResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	forward, ok, err := r.readURLForward(ctx, data.Domain.ValueString(), data.Subdomain.ValueString())
+	forward, ok, err := readURLForwardByID(ctx, r.client, data.Domain.ValueString(), data.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading URL Forward", err.Error())
 		return
@@ -156,22 +188,28 @@ func (r *URLForwardResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *URLForwardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data URLForwardResourceModel
+	var data, state URLForwardResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if _, err := r.client.Domains.DeleteDomainUrlForward(ctx, data.Domain.ValueString(), data.ID.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Error Deleting URL Forward for Update", err.Error())
-		return
-	}
-
+	// Porkbun cannot edit forwards, so they are replaced. The new forward is added
+	// before the old one is deleted to keep the host forwarded during the update.
 	id, err := r.createURLForward(ctx, &data)
 	if err != nil {
-		resp.Diagnostics.AddError("Error Creating URL Forward", err.Error())
+		resp.Diagnostics.AddError("Error Creating URL Forward for Update",
+			fmt.Sprintf("The updated forward could not be added, so the previous forward with ID %s was left unchanged: %s", state.ID.ValueString(), err))
 		return
 	}
+	if _, err := r.client.Domains.DeleteDomainUrlForward(ctx, state.Domain.ValueString(), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddWarning(
+			"Previous URL Forward Not Deleted",
+			fmt.Sprintf("The updated forward was created with ID %s, but the previous forward with ID %s could not be deleted and is no longer managed by Terraform. "+
+				"Delete it manually, for example in the Porkbun web interface: %s", id, state.ID.ValueString(), err),
+		)
+	}
 	data.ID = types.StringValue(id)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -190,53 +228,175 @@ func (r *URLForwardResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *URLForwardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.SplitN(req.ID, ":", 2)
+	domain, ref, ok := strings.Cut(req.ID, ":")
+	if !ok || domain == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", "Expected format: <domain>:<forward_id> or <domain>:<subdomain>")
+		return
+	}
 
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
-		resp.Diagnostics.AddError("Invalid Import ID", "Expected format: <domain>:<forward_id>")
+	forwards, err := listURLForwards(ctx, r.client, domain)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading URL Forwards", err.Error())
+		return
+	}
+	id, err := urlForwardIDForRef(forwards, domain, ref)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Importing URL Forward", err.Error())
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &URLForwardResourceModel{
-		ID:     types.StringValue(idParts[1]),
-		Domain: types.StringValue(idParts[0]),
+		ID:     types.StringValue(id),
+		Domain: types.StringValue(domain),
 	})...)
 }
 
+// checkConflicts reports existing DNS records and URL forwards of the domain
+// that conflict with the planned forward. The forward identified by
+// ownedForwardID belongs to the resource and is ignored. Conflicts are warnings
+// unless strict is set.
+func (r *URLForwardResource) checkConflicts(ctx context.Context, data *URLForwardResourceModel, ownedForwardID string, diagnostics *diag.Diagnostics) {
+	domain := data.Domain.ValueString()
+	addDiagnostic := diagnostics.AddAttributeWarning
+	if data.Strict.ValueBool() {
+		addDiagnostic = diagnostics.AddAttributeError
+	}
+
+	recordsResp, err := r.client.Dns.GetRecords(ctx, domain, nil)
+	if err != nil {
+		addDiagnostic(path.Root("domain"), "Error Checking for Conflicting Records", fmt.Sprintf("error fetching DNS records for domain %q: %s", domain, err))
+		return
+	}
+	forwards, err := listURLForwards(ctx, r.client, domain)
+	if err != nil {
+		addDiagnostic(path.Root("domain"), "Error Checking for Conflicting Records", err.Error())
+		return
+	}
+
+	var others []porkbun.UrlForwardData
+	for _, forward := range forwards {
+		if forward.Id != ownedForwardID {
+			others = append(others, forward)
+		}
+	}
+
+	conflicts := urlForwardConflicts(domain, recordsResp.Records, others, data.Subdomain.ValueString(), data.Wildcard.ValueBool())
+	if len(conflicts) > 0 {
+		addDiagnostic(
+			path.Root("subdomain"),
+			"Conflicting URL Forward",
+			fmt.Sprintf("The following existing entries of %s conflict with the URL forward, which will not work until they are removed:\n\n- %s",
+				domain, strings.Join(conflicts, "\n- ")),
+		)
+	}
+}
+
+// urlForwardConflicts returns a description of every record or URL forward that
+// conflicts with a forward of the subdomain.
+//
+// Address and CNAME records at the forwarded host, or at any host covered by a
+// wildcard forward, conflict with it, except for Porkbun's default parking
+// records. Other forwards conflict if either of them covers the host of the
+// other.
+func urlForwardConflicts(domain string, records []porkbun.DnsRecord, forwards []porkbun.UrlForwardData, subdomain string, wildcard bool) []string {
+	var conflicts []string
+
+	for _, record := range records {
+		if (!isAddressRecordType(record.Type) && record.Type != porkbun.CNAME) || isPorkbunParkingRecord(record) {
+			continue
+		}
+		host, ok := dnsname.Subdomain(record.Name, domain)
+		if !ok || !forwardCovers(subdomain, wildcard, host) {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("%s record %q at %s", record.Type, record.Content, dnsname.Join(host, domain)))
+	}
+
+	for _, forward := range forwards {
+		otherWildcard := forward.Wildcard == "yes"
+		if !forwardCovers(subdomain, wildcard, forward.Subdomain) && !forwardCovers(forward.Subdomain, otherWildcard, subdomain) {
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("URL forward %s to %q at %s", forward.Id, forward.Location, dnsname.Join(forward.Subdomain, domain)))
+	}
+
+	return conflicts
+}
+
+// forwardCovers reports whether a forward of the subdomain applies to the host.
+// Wildcard forwards also apply to every host below the subdomain.
+func forwardCovers(subdomain string, wildcard bool, host string) bool {
+	if host == subdomain {
+		return true
+	}
+	if !wildcard {
+		return false
+	}
+	if subdomain == "" {
+		return host != ""
+	}
+	return strings.HasSuffix(host, "."+subdomain)
+}
+
 // createURLForward creates a new URL forward for the specified domain and subdomain.
 func (r *URLForwardResource) createURLForward(ctx context.Context, data *URLForwardResourceModel) (string, error) {
-	opts := porkbun.UrlForward{
+	return addURLForward(ctx, r.client, data.Domain.ValueString(), &porkbun.UrlForward{
 		Subdomain:   data.Subdomain.ValueString(),
 		Location:    data.Location.ValueString(),
 		Type:        porkbun.ForwardType(data.Type.ValueString()),
 		IncludePath: encodeBool(data.IncludePath.ValueBool()),
 		Wildcard:    encodeBool(data.Wildcard.ValueBool()),
+	})
+}
+
+// addURLForward adds a URL forward to the domain and returns its ID. The Porkbun
+// API does not return the ID on creation, so it is determined by comparing the
+// forwards before and after adding it.
+func addURLForward(ctx context.Context, client *porkbun.Client, domain string, forward *porkbun.UrlForward) (string, error) {
+	before, err := listURLForwards(ctx, client, domain)
+	if err != nil {
+		return "", err
+	}
+	existing := make(map[string]bool, len(before))
+	for _, f := range before {
+		existing[f.Id] = true
 	}
 
-	if _, err := r.client.Domains.AddDomainUrlForward(ctx, data.Domain.ValueString(), &opts); err != nil {
+	if _, err := client.Domains.AddDomainUrlForward(ctx, domain, forward); err != nil {
 		return "", err
 	}
 
-	forward, ok, err := r.readURLForward(ctx, data.Domain.ValueString(), data.Subdomain.ValueString())
+	after, err := listURLForwards(ctx, client, domain)
 	if err != nil {
 		return "", err
 	}
-	if !ok {
-		return "", fmt.Errorf("URL forward for domain %s and subdomain %s not found after creation", data.Domain.ValueString(), data.Subdomain.ValueString())
+	for _, f := range after {
+		if !existing[f.Id] && f.Subdomain == forward.Subdomain {
+			return f.Id, nil
+		}
 	}
 
-	return forward.Id, nil
+	return "", fmt.Errorf("URL forward for domain %s and subdomain %q not found after creation", domain, forward.Subdomain)
 }
 
-// readURLForward retrieves the URL forward for the specified domain and subdomain.
-func (r *URLForwardResource) readURLForward(ctx context.Context, domain, subdomain string) (*porkbun.UrlForwardData, bool, error) {
-	resp, err := r.client.Domains.GetDomainURLForwarding(ctx, domain)
+// listURLForwards retrieves all URL forwards of the domain.
+func listURLForwards(ctx context.Context, client *porkbun.Client, domain string) ([]porkbun.UrlForwardData, error) {
+	resp, err := client.Domains.GetDomainURLForwarding(ctx, domain)
 	if err != nil {
-		return nil, false, fmt.Errorf("failed fetching URL forwards for domain %s: %w", domain, err)
+		return nil, fmt.Errorf("failed fetching URL forwards for domain %s: %w", domain, err)
 	}
+	return resp.Forwards, nil
+}
 
-	for _, forward := range resp.Forwards {
-		if forward.Subdomain == subdomain {
+// readURLForwardByID retrieves the URL forward with the specified ID.
+func readURLForwardByID(ctx context.Context, client *porkbun.Client, domain, id string) (*porkbun.UrlForwardData, bool, error) {
+	forwards, err := listURLForwards(ctx, client, domain)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, forward := range forwards {
+		if forward.Id == id {
 			return &forward, true, nil
 		}
 	}
@@ -244,6 +404,39 @@ func (r *URLForwardResource) readURLForward(ctx context.Context, domain, subdoma
 	return nil, false, nil
 }
 
+// urlForwardIDForRef returns the ID of the forward identified by the import
+// reference, which is either a forward ID or a subdomain. IDs take precedence,
+// so numeric subdomains such as "2024" are only looked up if no forward has
+// that ID.
+func urlForwardIDForRef(forwards []porkbun.UrlForwardData, domain, ref string) (string, error) {
+	for _, forward := range forwards {
+		if forward.Id == ref {
+			return ref, nil
+		}
+	}
+	return urlForwardIDForSubdomain(forwards, domain, ref)
+}
+
+// urlForwardIDForSubdomain returns the ID of the only forward for the subdomain.
+func urlForwardIDForSubdomain(forwards []porkbun.UrlForwardData, domain, subdomain string) (string, error) {
+	var ids []string
+	for _, forward := range forwards {
+		if forward.Subdomain == subdomain {
+			ids = append(ids, forward.Id)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no URL forward found for subdomain %q of domain %s", subdomain, domain)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d URL forwards for subdomain %q of domain %s, import one of them by ID instead: %s",
+			len(ids), subdomain, domain, strings.Join(ids, ", "))
+	}
+}
+
 // encodeBool converts a boolean value to a string representation.
 func encodeBool(b bool) string {
 	if b {
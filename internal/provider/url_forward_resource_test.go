@@ -1,14 +1,22 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tuzzmaniandevil/porkbun-go"
 )
 
 func TestAccURLForwardResource(t *testing.T) {
@@ -126,3 +134,399 @@ resource "porkbun_url_forward" "test" {
 }
 `, testAccDomain(), subdomain, location, redirectType, includePath, wildcard)
 }
+
+func TestAddURLForward(t *testing.T) {
+	api := newFakeAPI()
+	client := newTestClient(t, api)
+	ctx := context.Background()
+
+	// A forward for the same subdomain created outside Terraform must not be mistaken for the new one.
+	api.forwards["example.com"] = []porkbun.UrlForwardData{urlForwardData("1", "www", "https://other.example")}
+
+	id, err := addURLForward(ctx, client, "example.com", &porkbun.UrlForward{Subdomain: "www", Location: "https://example.org"})
+	if err != nil {
+		t.Fatalf("addURLForward() unexpected error: %v", err)
+	}
+	if id == "1" {
+		t.Errorf("addURLForward() returned the ID of the existing forward")
+	}
+	if forward, ok, _ := readURLForwardByID(ctx, client, "example.com", id); !ok || forward.Location != "https://example.org" {
+		t.Errorf("readURLForwardByID() = %v, %v, want the created forward", forward, ok)
+	}
+}
+
+func TestURLForwardResource_Update(t *testing.T) {
+	api := newFakeAPI()
+	r := &URLForwardResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	state := URLForwardResourceModel{
+		Domain:      types.StringValue("example.com"),
+		Subdomain:   types.StringValue(""),
+		Location:    types.StringValue("https://example.org"),
+		Type:        types.StringValue("temporary"),
+		IncludePath: types.BoolValue(false),
+		Wildcard:    types.BoolValue(false),
+	}
+	id, err := r.createURLForward(ctx, &state)
+	if err != nil {
+		t.Fatalf("createURLForward() unexpected error: %v", err)
+	}
+	state.ID = types.StringValue(id)
+
+	plan := state
+	plan.ID = types.StringUnknown()
+	plan.Location = types.StringValue("https://example.net")
+
+	req := fwresource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	req.Plan.Set(ctx, &plan)
+	req.State.Set(ctx, &state)
+	resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	api.calls = nil
+	r.Update(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update() unexpected error: %v", resp.Diagnostics)
+	}
+
+	// The replacement must be added before the previous forward is deleted.
+	if add, del := slices.Index(api.calls, "domain/addUrlForward"), slices.Index(api.calls, "domain/deleteUrlForward"); add < 0 || del < add {
+		t.Errorf("API calls = %v, want the forward to be added before the old one is deleted", api.calls)
+	}
+
+	var got URLForwardResourceModel
+	resp.State.Get(ctx, &got)
+	forwards := api.forwardsOf("example.com")
+	if len(forwards) != 1 || forwards[0].Id != got.ID.ValueString() || forwards[0].Location != "https://example.net" {
+		t.Errorf("forwards after update = %v, state ID %s", forwards, got.ID)
+	}
+}
+
+func TestURLForwardResource_UpdateFailure(t *testing.T) {
+	tests := []struct {
+		name        string
+		failAction  string
+		wantErr     bool
+		wantWarning bool
+		// wantLocations are the locations of the forwards left after the update.
+		wantLocations []string
+	}{
+		{
+			name:          "add fails",
+			failAction:    "domain/addUrlForward",
+			wantErr:       true,
+			wantLocations: []string{"https://example.org"},
+		},
+		{
+			name:          "delete fails",
+			failAction:    "domain/deleteUrlForward",
+			wantWarning:   true,
+			wantLocations: []string{"https://example.org", "https://example.net"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := newFakeAPI()
+			r := &URLForwardResource{client: newTestClient(t, api)}
+			ctx := context.Background()
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			state := URLForwardResourceModel{
+				Domain:      types.StringValue("example.com"),
+				Subdomain:   types.StringValue(""),
+				Location:    types.StringValue("https://example.org"),
+				Type:        types.StringValue("temporary"),
+				IncludePath: types.BoolValue(false),
+				Wildcard:    types.BoolValue(false),
+			}
+			id, err := r.createURLForward(ctx, &state)
+			if err != nil {
+				t.Fatalf("createURLForward() unexpected error: %v", err)
+			}
+			state.ID = types.StringValue(id)
+
+			plan := state
+			plan.ID = types.StringUnknown()
+			plan.Location = types.StringValue("https://example.net")
+
+			req := fwresource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			req.Plan.Set(ctx, &plan)
+			req.State.Set(ctx, &state)
+			resp := fwresource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			api.calls = nil
+			api.failures[tt.failAction] = "internal error"
+			r.Update(ctx, req, &resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("Update() diagnostics = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+			if tt.wantErr && slices.Contains(api.calls, "domain/deleteUrlForward") {
+				t.Errorf("API calls = %v, want no delete after the add failed", api.calls)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+				t.Errorf("Update() diagnostics = %v, wantWarning %v", resp.Diagnostics, tt.wantWarning)
+			}
+			if tt.wantWarning && !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), id) {
+				t.Errorf("Update() warning = %q, want it to mention the previous ID %s", resp.Diagnostics.Warnings()[0].Detail(), id)
+			}
+
+			var locations []string
+			for _, forward := range api.forwardsOf("example.com") {
+				locations = append(locations, forward.Location)
+			}
+			if !slices.Equal(locations, tt.wantLocations) {
+				t.Errorf("forward locations after update = %v, want %v", locations, tt.wantLocations)
+			}
+
+			if !tt.wantErr {
+				var got URLForwardResourceModel
+				resp.State.Get(ctx, &got)
+				if got.ID.ValueString() == id {
+					t.Errorf("state ID = %s, want the ID of the new forward", got.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestURLForwardIDForRef(t *testing.T) {
+	forwards := []porkbun.UrlForwardData{
+		urlForwardData("1", "", "https://example.org"),
+		urlForwardData("5", "2024", "https://example.org/2024"),
+		urlForwardData("2024", "www", "https://example.org"),
+		urlForwardData("6", "2025", "https://example.org/2025"),
+	}
+
+	tests := []struct {
+		ref     string
+		want    string
+		wantErr bool
+	}{
+		{ref: "1", want: "1"},
+		{ref: "2024", want: "2024"},
+		{ref: "2025", want: "6"},
+		{ref: "www", want: "2024"},
+		{ref: "", want: "1"},
+		{ref: "2026", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := urlForwardIDForRef(forwards, "example.com", tt.ref)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("urlForwardIDForRef(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("urlForwardIDForRef(%q) = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestURLForwardIDForSubdomain(t *testing.T) {
+	forwards := []porkbun.UrlForwardData{
+		urlForwardData("1", "", "https://example.org"),
+		urlForwardData("2", "www", "https://example.org"),
+		urlForwardData("3", "shop", "https://shop.example.org"),
+		urlForwardData("4", "shop", "https://store.example.org"),
+	}
+
+	tests := []struct {
+		subdomain string
+		want      string
+		wantErr   bool
+	}{
+		{subdomain: "", want: "1"},
+		{subdomain: "www", want: "2"},
+		{subdomain: "shop", wantErr: true},
+		{subdomain: "blog", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := urlForwardIDForSubdomain(forwards, "example.com", tt.subdomain)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("urlForwardIDForSubdomain(%q) error = %v, wantErr %v", tt.subdomain, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("urlForwardIDForSubdomain(%q) = %q, want %q", tt.subdomain, got, tt.want)
+		}
+	}
+}
+
+func TestURLForwardConflicts(t *testing.T) {
+	wildcardRoot := urlForwardData("10", "", "https://example.org")
+	wildcardRoot.Wildcard = "yes"
+
+	tests := []struct {
+		name      string
+		records   []porkbun.DnsRecord
+		forwards  []porkbun.UrlForwardData
+		subdomain string
+		wildcard  bool
+		want      int
+	}{
+		{
+			name: "unrelated records",
+			records: []porkbun.DnsRecord{
+				{Name: "www.example.com", Type: porkbun.TXT, Content: "verify"},
+				{Name: "www.example.com", Type: porkbun.MX, Content: "mx.example.net"},
+				{Name: "blog.example.com", Type: porkbun.CNAME, Content: "example.net"},
+			},
+			forwards:  []porkbun.UrlForwardData{urlForwardData("1", "blog", "https://example.org")},
+			subdomain: "www",
+		},
+		{
+			name: "parking records",
+			records: []porkbun.DnsRecord{
+				{Name: "example.com", Type: porkbun.ALIAS, Content: "pixie.porkbun.com"},
+				{Name: "*.example.com", Type: porkbun.CNAME, Content: "pixie.porkbun.com"},
+			},
+			wildcard: true,
+		},
+		{
+			name: "address records at host",
+			records: []porkbun.DnsRecord{
+				{Name: "www.example.com", Type: porkbun.A, Content: "192.0.2.1"},
+				{Name: "www.example.com", Type: porkbun.CNAME, Content: "example.net"},
+			},
+			subdomain: "www",
+			want:      2,
+		},
+		{
+			name: "records below wildcard forward",
+			records: []porkbun.DnsRecord{
+				{Name: "example.com", Type: porkbun.ALIAS, Content: "example.net"},
+				{Name: "a.shop.example.com", Type: porkbun.AAAA, Content: "2001:db8::1"},
+				{Name: "myshop.example.com", Type: porkbun.A, Content: "192.0.2.1"},
+			},
+			subdomain: "shop",
+			wildcard:  true,
+			want:      1,
+		},
+		{
+			name:      "forward at same host",
+			forwards:  []porkbun.UrlForwardData{urlForwardData("1", "www", "https://example.org")},
+			subdomain: "www",
+			want:      1,
+		},
+		{
+			name:      "existing wildcard forward covers host",
+			forwards:  []porkbun.UrlForwardData{wildcardRoot},
+			subdomain: "www",
+			want:      1,
+		},
+		{
+			name:      "wildcard forward covers existing forward",
+			forwards:  []porkbun.UrlForwardData{urlForwardData("1", "www", "https://example.org")},
+			subdomain: "",
+			wildcard:  true,
+			want:      1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := urlForwardConflicts("example.com", tt.records, tt.forwards, tt.subdomain, tt.wildcard)
+			if len(got) != tt.want {
+				t.Errorf("urlForwardConflicts() = %q, want %d conflicts", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestURLForwardResource_checkConflicts(t *testing.T) {
+	api := newFakeAPI()
+	r := &URLForwardResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	id := int64(1)
+	api.records["example.com"] = []porkbun.DnsRecord{{ID: &id, Name: "www.example.com", Type: porkbun.CNAME, Content: "example.net"}}
+	api.forwards["example.com"] = []porkbun.UrlForwardData{urlForwardData("2", "www", "https://example.org")}
+
+	model := URLForwardResourceModel{
+		Domain:    types.StringValue("example.com"),
+		Subdomain: types.StringValue("www"),
+		Wildcard:  types.BoolValue(false),
+	}
+
+	var diags diag.Diagnostics
+	r.checkConflicts(ctx, &model, "2", &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("checkConflicts() = %v, want a single warning", diags)
+	}
+
+	model.Strict = types.BoolValue(true)
+	diags = nil
+	r.checkConflicts(ctx, &model, "", &diags)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 0 {
+		t.Fatalf("checkConflicts() = %v, want a single error in strict mode", diags)
+	}
+}
+
+func TestURLForwardResource_ModifyPlan(t *testing.T) {
+	api := newFakeAPI()
+	r := &URLForwardResource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	id := int64(1)
+	api.records["example.com"] = []porkbun.DnsRecord{{ID: &id, Name: "www.example.com", Type: porkbun.CNAME, Content: "example.net"}}
+
+	model := URLForwardResourceModel{
+		ID:          types.StringValue("2"),
+		Domain:      types.StringValue("example.com"),
+		Subdomain:   types.StringValue("www"),
+		Location:    types.StringValue("https://example.org"),
+		Type:        types.StringValue("temporary"),
+		IncludePath: types.BoolValue(false),
+		Wildcard:    types.BoolValue(false),
+		Strict:      types.BoolValue(true),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(ctx, &model)
+
+	tests := []struct {
+		name      string
+		subdomain string
+		wantCheck bool
+	}{
+		{name: "unchanged host", subdomain: "www"},
+		{name: "changed host", subdomain: "", wantCheck: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned := model
+			planned.Subdomain = types.StringValue(tt.subdomain)
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			plan.Set(ctx, &planned)
+
+			api.calls = nil
+			resp := fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() unexpected error: %v", resp.Diagnostics)
+			}
+			if got := slices.Contains(api.calls, "dns/retrieve"); got != tt.wantCheck {
+				t.Errorf("ModifyPlan() checked for conflicts = %v, want %v (calls %v)", got, tt.wantCheck, api.calls)
+			}
+		})
+	}
+}
+
+// urlForwardData returns a URL forward as returned by the Porkbun API.
+func urlForwardData(id, subdomain, location string) porkbun.UrlForwardData {
+	forward := porkbun.UrlForwardData{Id: id}
+	forward.Subdomain = subdomain
+	forward.Location = location
+	forward.Type = porkbun.Temporary
+	forward.IncludePath = "no"
+	forward.Wildcard = "no"
+	return forward
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/tuzzmaniandevil/porkbun-go"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/util"
+)
+
+var _ datasource.DataSource = &URLForwardsDataSource{}
+
+// urlForwardObjectAttrs defines the attributes for the URL forward object.
+var urlForwardObjectAttrs = map[string]attr.Type{
+	"id":           types.StringType,
+	"subdomain":    types.StringType,
+	"location":     types.StringType,
+	"type":         types.StringType,
+	"include_path": types.BoolType,
+	"wildcard":     types.BoolType,
+}
+
+func NewURLForwardsDataSource() datasource.DataSource {
+	return &URLForwardsDataSource{}
+}
+
+// URLForwardsDataSource defines the data source implementation.
+type URLForwardsDataSource struct {
+	client *porkbun.Client
+}
+
+// URLForwardsDataSourceModel describes the data source data model.
+type URLForwardsDataSourceModel struct {
+	Domain    types.String `tfsdk:"domain"`
+	Subdomain types.String `tfsdk:"subdomain"`
+	Forwards  types.List   `tfsdk:"forwards"`
+}
+
+func (d *URLForwardsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_url_forwards"
+}
+
+func (d *URLForwardsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the URL forwarding rules of a domain registered with Porkbun, including those created through the web interface.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain name to retrieve URL forwards for (e.g., example.com).",
+				Required:            true,
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "Only return forwards for this subdomain. Use an empty string for the root domain. Defaults to returning all forwards.",
+				Optional:            true,
+			},
+			"forwards": schema.ListAttribute{
+				MarkdownDescription: "A list of URL forwards of the domain.",
+				Computed:            true,
+				ElementType: types.ObjectType{
+					AttrTypes: urlForwardObjectAttrs,
+				},
+			},
+		},
+	}
+}
+
+func (d *URLForwardsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = getPorkbunClient(req.ProviderData, resp.Diagnostics)
+}
+
+func (d *URLForwardsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data URLForwardsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	forwards, err := listURLForwards(ctx, d.client, data.Domain.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading URL Forwards", err.Error())
+		return
+	}
+
+	if !data.Subdomain.IsNull() {
+		filtered := make([]porkbun.UrlForwardData, 0, len(forwards))
+		for _, forward := range forwards {
+			if forward.Subdomain == data.Subdomain.ValueString() {
+				filtered = append(filtered, forward)
+			}
+		}
+		forwards = filtered
+	}
+
+	data.Forwards = convertURLForwardsToList(forwards, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// convertURLForwardsToList converts a slice of porkbun.UrlForwardData to a types.List.
+func convertURLForwardsToList(forwards []porkbun.UrlForwardData, diagnostics *diag.Diagnostics) types.List {
+	return util.MustMapToList(forwards, types.ObjectType{AttrTypes: urlForwardObjectAttrs}, func(forward porkbun.UrlForwardData) attr.Value {
+		return types.ObjectValueMust(
+			urlForwardObjectAttrs,
+			map[string]attr.Value{
+				"id":           types.StringValue(forward.Id),
+				"subdomain":    types.StringValue(forward.Subdomain),
+				"location":     types.StringValue(forward.Location),
+				"type":         types.StringValue(string(forward.Type)),
+				"include_path": util.BoolValue(forward.IncludePath, diagnostics),
+				"wildcard":     util.BoolValue(forward.Wildcard, diagnostics),
+			},
+		)
+	})
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/tuzzmaniandevil/porkbun-go"
+)
+
+func TestAccURLForwardsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccURLForwardsDataSourceConfig(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.porkbun_url_forwards.test",
+						tfjsonpath.New("forwards"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccURLForwardsDataSourceConfig() string {
+	return fmt.Sprintf(`
+data "porkbun_url_forwards" "test" {
+  domain = %q
+}
+`, testAccDomain())
+}
+
+func TestURLForwardsDataSource_Read(t *testing.T) {
+	api := newFakeAPI()
+	api.forwards["example.com"] = []porkbun.UrlForwardData{
+		urlForwardData("1", "", "https://example.org"),
+		urlForwardData("2", "promo", "https://example.org/promo"),
+		urlForwardData("3", "promo", "https://example.org/sale"),
+	}
+	d := &URLForwardsDataSource{client: newTestClient(t, api)}
+	ctx := context.Background()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	tests := []struct {
+		name      string
+		subdomain types.String
+		wantIDs   []string
+	}{
+		{name: "all", subdomain: types.StringNull(), wantIDs: []string{"1", "2", "3"}},
+		{name: "root domain", subdomain: types.StringValue(""), wantIDs: []string{"1"}},
+		{name: "subdomain", subdomain: types.StringValue("promo"), wantIDs: []string{"2", "3"}},
+		{name: "no match", subdomain: types.StringValue("blog"), wantIDs: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+			config.Set(ctx, &URLForwardsDataSourceModel{
+				Domain:    types.StringValue("example.com"),
+				Subdomain: tt.subdomain,
+				Forwards:  types.ListNull(types.ObjectType{AttrTypes: urlForwardObjectAttrs}),
+			})
+
+			req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config.Raw}}
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read() unexpected error: %v", resp.Diagnostics)
+			}
+
+			var data URLForwardsDataSourceModel
+			resp.State.Get(ctx, &data)
+			var gotIDs []string
+			for _, forward := range data.Forwards.Elements() {
+				gotIDs = append(gotIDs, forward.(types.Object).Attributes()["id"].(types.String).ValueString())
+			}
+			if fmt.Sprint(gotIDs) != fmt.Sprint(tt.wantIDs) {
+				t.Errorf("Read() forward IDs = %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
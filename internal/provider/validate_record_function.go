@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ValidateRecordFunction{}
+
+func NewValidateRecordFunction() function.Function {
+	return &ValidateRecordFunction{}
+}
+
+// ValidateRecordFunction checks the arguments of a DNS record using the rules of porkbun_dns_record.
+type ValidateRecordFunction struct{}
+
+func (f *ValidateRecordFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_record"
+}
+
+func (f *ValidateRecordFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate the arguments of a DNS record",
+		MarkdownDescription: "Checks the arguments of a DNS record using the same rules `porkbun_dns_record` applies at plan time, " +
+			"and returns a list of human-readable problems, each prefixed with the name of the argument. " +
+			"The list is empty if the record is valid. " +
+			"This allows modules accepting records as input variables to reject invalid records in a `validation` block.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "The record type, for example `A` or `MX`.",
+			},
+			function.StringParameter{
+				Name:                "subdomain",
+				MarkdownDescription: "The subdomain of the record. Use an empty string for the domain itself.",
+			},
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "The content of the record.",
+			},
+			function.Int64Parameter{
+				Name:                "prio",
+				MarkdownDescription: "The priority of the record. `null` is treated as `0`.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ValidateRecordFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var recordType, subdomain, content string
+	var prio types.Int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &recordType, &subdomain, &content, &prio))
+	if resp.Error != nil {
+		return
+	}
+
+	problems := make([]attr.Value, 0)
+	for _, problem := range validateDNSRecord(ctx, recordType, subdomain, content, prio.ValueInt64()) {
+		problems = append(problems, types.StringValue(problem.String()))
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, types.ListValueMust(types.StringType, problems)))
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateRecordFunction(t *testing.T) {
+	got, funcErr := runFunction(t, NewValidateRecordFunction(),
+		types.StringValue("MX"), types.StringValue(""), types.StringValue("mail.example.com"), types.Int64Null())
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+	if want := types.ListValueMust(types.StringType, []attr.Value{}); !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+
+	got, funcErr = runFunction(t, NewValidateRecordFunction(),
+		types.StringValue("PTR"), types.StringValue("www."), types.StringValue("host.example.com"), types.Int64Value(0))
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+	want := types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(`type: Value "PTR" must be one of: [A MX CNAME ALIAS TXT NS AAAA SRV TLSA CAA HTTPS SVCB]`),
+		types.StringValue(`subdomain: Subdomain "www." must be relative to the domain and not end with a dot.`),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+
+	got, funcErr = runFunction(t, NewValidateRecordFunction(),
+		types.StringValue("A"), types.StringValue("www"), types.StringValue("192.0.2"), types.Int64Null())
+	if funcErr != nil {
+		t.Fatalf("Run() unexpected error: %v", funcErr)
+	}
+	want = types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue(`content: "192.0.2" is not an IPv4 address.`),
+	})
+	if !got.Equal(want) {
+		t.Errorf("Run() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,142 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+// DefaultPort is the port used for resolver addresses that do not specify one.
+const DefaultPort = "53"
+
+// RcodeError is returned by Query for responses with a non-success rcode.
+type RcodeError struct {
+	Server string
+	Name   string
+	Qtype  uint16
+	Rcode  int
+}
+
+func (e *RcodeError) Error() string {
+	return fmt.Sprintf("%s answered %s for %s %s", e.Server, dns.RcodeToString[e.Rcode], e.Name, dns.TypeToString[e.Qtype])
+}
+
+// Client queries DNS servers directly, bypassing the system resolver.
+type Client struct {
+	client *dns.Client
+}
+
+// NewClient creates a new Client that gives up on a single query after the given timeout.
+func NewClient(timeout time.Duration) *Client {
+	return &Client{
+		client: &dns.Client{Timeout: timeout},
+	}
+}
+
+// Query sends a single question for name and qtype to the given server, retrying over TCP
+// if the UDP response was truncated.
+//
+// The server may be given as "host" or "host:port"; DefaultPort is used if no port is set.
+// Responses with a non-success rcode other than NXDOMAIN are returned as *RcodeError.
+func (c *Client) Query(ctx context.Context, server, name string, qtype uint16) (*dns.Msg, error) {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name), qtype)
+	msg.RecursionDesired = true
+
+	addr := NormalizeAddress(server)
+	resp, _, err := c.client.ExchangeContext(ctx, msg, addr)
+	if err != nil {
+		return nil, fmt.Errorf("error querying %s for %s %s: %w", addr, name, dns.TypeToString[qtype], err)
+	}
+
+	if resp.Truncated {
+		tcpClient := *c.client
+		tcpClient.Net = "tcp"
+		resp, _, err = tcpClient.ExchangeContext(ctx, msg, addr)
+		if err != nil {
+			return nil, fmt.Errorf("error querying %s over TCP for %s %s: %w", addr, name, dns.TypeToString[qtype], err)
+		}
+	}
+
+	if resp.Rcode != dns.RcodeSuccess && resp.Rcode != dns.RcodeNameError {
+		return nil, &RcodeError{Server: addr, Name: name, Qtype: qtype, Rcode: resp.Rcode}
+	}
+
+	return resp, nil
+}
+
+// NormalizeAddress returns the address in "host:port" form, adding DefaultPort if required.
+func NormalizeAddress(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if _, _, err := net.SplitHostPort(addr); err == nil {
+		return addr
+	}
+	return net.JoinHostPort(strings.Trim(addr, "[]"), DefaultPort)
+}
+
+// ContainsRR reports whether rrs contains a record equal to want, ignoring TTLs.
+func ContainsRR(rrs []dns.RR, want dns.RR) bool {
+	for _, rr := range rrs {
+		if dns.IsDuplicate(rr, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// WaitForRR polls every server until each of them answers with a record equal to want.
+//
+// It returns nil once all servers agree, or an error describing the servers that have
+// not yet converged when ctx is done.
+func (c *Client) WaitForRR(ctx context.Context, servers []string, want dns.RR, interval time.Duration) error {
+	pending := make(map[string]error, len(servers))
+	for _, server := range servers {
+		pending[server] = nil
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for server := range pending {
+			resp, err := c.Query(ctx, server, want.Header().Name, want.Header().Rrtype)
+			switch {
+			case err != nil:
+				pending[server] = err
+			case ContainsRR(resp.Answer, want):
+				delete(pending, server)
+			default:
+				pending[server] = fmt.Errorf("%s does not serve the expected record yet", NormalizeAddress(server))
+			}
+		}
+
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("record %q was not propagated to all resolvers: %w", want.String(), describePending(pending))
+		case <-ticker.C:
+		}
+	}
+}
+
+// describePending joins the last errors seen for each pending server.
+func describePending(pending map[string]error) error {
+	msgs := make([]string, 0, len(pending))
+	for server, err := range pending {
+		if err == nil {
+			err = fmt.Errorf("%s was not queried", NormalizeAddress(server))
+		}
+		msgs = append(msgs, err.Error())
+	}
+	sort.Strings(msgs)
+	return errors.New(strings.Join(msgs, "; "))
+}
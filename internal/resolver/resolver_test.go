@@ -0,0 +1,96 @@
+package resolver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver"
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/resolver/resolvertest"
+)
+
+// mustRR parses a record in zone file format or fails the test.
+func mustRR(t *testing.T, s string) dns.RR {
+	t.Helper()
+	rr, err := dns.NewRR(s)
+	if err != nil {
+		t.Fatalf("failed to parse record %q: %v", s, err)
+	}
+	return rr
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"1.1.1.1", "1.1.1.1:53"},
+		{"1.1.1.1:5353", "1.1.1.1:5353"},
+		{"ns1.example.com", "ns1.example.com:53"},
+		{"2001:db8::1", "[2001:db8::1]:53"},
+		{"[2001:db8::1]", "[2001:db8::1]:53"},
+		{"[2001:db8::1]:5353", "[2001:db8::1]:5353"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := resolver.NormalizeAddress(tt.in); got != tt.want {
+				t.Errorf("NormalizeAddress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Query(t *testing.T) {
+	server := resolvertest.NewServer(t, "www.example.com. 600 IN A 192.0.2.1")
+
+	resp, err := resolver.NewClient(time.Second).Query(context.Background(), server.Addr, "www.example.com", dns.TypeA)
+	if err != nil {
+		t.Fatalf("Query() unexpected error: %v", err)
+	}
+	if !resolver.ContainsRR(resp.Answer, mustRR(t, "www.example.com. 1 IN A 192.0.2.1")) {
+		t.Errorf("Query() answer = %v, want the A record", resp.Answer)
+	}
+}
+
+func TestClient_Query_ServerFailure(t *testing.T) {
+	server := resolvertest.NewServer(t)
+	server.SetRcode(dns.RcodeServerFailure)
+
+	_, err := resolver.NewClient(time.Second).Query(context.Background(), server.Addr, "www.example.com", dns.TypeA)
+	var rcodeErr *resolver.RcodeError
+	if !errors.As(err, &rcodeErr) || rcodeErr.Rcode != dns.RcodeServerFailure {
+		t.Fatalf("Query() error = %v, want SERVFAIL RcodeError", err)
+	}
+}
+
+func TestClient_WaitForRR(t *testing.T) {
+	server := resolvertest.NewServer(t, `www.example.com. 600 IN TXT "old"`)
+	want := mustRR(t, `www.example.com. 600 IN TXT "new"`)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = server.SetRecords(`www.example.com. 600 IN TXT "new"`)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := resolver.NewClient(time.Second).WaitForRR(ctx, []string{server.Addr}, want, 10*time.Millisecond); err != nil {
+		t.Fatalf("WaitForRR() unexpected error: %v", err)
+	}
+}
+
+func TestClient_WaitForRR_Timeout(t *testing.T) {
+	server := resolvertest.NewServer(t, `www.example.com. 600 IN TXT "old"`)
+	want := mustRR(t, `www.example.com. 600 IN TXT "new"`)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if err := resolver.NewClient(time.Second).WaitForRR(ctx, []string{server.Addr}, want, 10*time.Millisecond); err == nil {
+		t.Fatal("WaitForRR() expected error, got nil")
+	}
+}
@@ -0,0 +1,88 @@
+// Package resolvertest provides a local DNS server for testing code that queries nameservers.
+package resolvertest
+
+import (
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/miekg/dns"
+)
+
+// Server is a minimal authoritative DNS server answering from a mutable set of records.
+type Server struct {
+	// Addr is the "host:port" address the server is listening on.
+	Addr string
+
+	mu      sync.Mutex
+	records []dns.RR
+	rcode   int
+}
+
+// NewServer starts a Server on a random local UDP port serving the given records,
+// which are given in zone file format. The server is shut down when the test ends.
+func NewServer(t *testing.T, records ...string) *Server {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	s := &Server{Addr: conn.LocalAddr().String()}
+	if err := s.SetRecords(records...); err != nil {
+		t.Fatal(err)
+	}
+
+	started := make(chan struct{})
+	server := &dns.Server{PacketConn: conn, Handler: s, NotifyStartedFunc: func() { close(started) }}
+	go func() { _ = server.ActivateAndServe() }()
+	<-started
+	t.Cleanup(func() { _ = server.Shutdown() })
+
+	return s
+}
+
+// SetRecords replaces the records served by the server.
+func (s *Server) SetRecords(records ...string) error {
+	rrs := make([]dns.RR, 0, len(records))
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		if err != nil {
+			return err
+		}
+		rrs = append(rrs, rr)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = rrs
+	return nil
+}
+
+// SetRcode makes the server answer every query with the given rcode and no records.
+// Passing dns.RcodeSuccess restores normal operation.
+func (s *Server) SetRcode(rcode int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rcode = rcode
+}
+
+// ServeDNS implements dns.Handler.
+func (s *Server) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	resp := new(dns.Msg)
+	resp.SetRcode(req, s.rcode)
+	resp.Authoritative = true
+	if s.rcode == dns.RcodeSuccess {
+		q := req.Question[0]
+		for _, rr := range s.records {
+			if rr.Header().Rrtype == q.Qtype && dns.CanonicalName(rr.Header().Name) == dns.CanonicalName(q.Name) {
+				resp.Answer = append(resp.Answer, rr)
+			}
+		}
+	}
+	_ = w.WriteMsg(resp)
+}
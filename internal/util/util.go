@@ -18,6 +18,15 @@ func MustMapToList[T any](elements []T, elementType attr.Type, f func(T) attr.Va
 	return types.ListValueMust(elementType, values)
 }
 
+// MustMapToSet converts a slice of elements of type T to a types.Set.
+func MustMapToSet[T any](elements []T, elementType attr.Type, f func(T) attr.Value) types.Set {
+	values := make([]attr.Value, 0, len(elements))
+	for _, v := range elements {
+		values = append(values, f(v))
+	}
+	return types.SetValueMust(elementType, values)
+}
+
 // BoolValue parses a string or bool value into a types.Bool.
 func BoolValue[T bool | string](value T, diagnostics *diag.Diagnostics) types.Bool {
 	switch v := any(value).(type) {
@@ -33,9 +42,11 @@ func BoolValue[T bool | string](value T, diagnostics *diag.Diagnostics) types.Bo
 
 // Int64PointerValue parses a string or bool pointer value into a types.Int64.1.
 func Int64PointerValue[T int64 | string](value *T, diagnostics *diag.Diagnostics) types.Int64 {
-	switch v := any(value).(type) {
-	case nil:
+	if value == nil {
 		return types.Int64Null()
+	}
+
+	switch v := any(value).(type) {
 	case *int64:
 		return Int64Value(*v, diagnostics)
 	case *string:
@@ -64,3 +75,44 @@ func Int64Value[T int64 | string](value T, diagnostics *diag.Diagnostics) types.
 	}
 	return types.Int64Null()
 }
+
+// StringsFromList converts a types.List of strings to a slice.
+//
+// A null list results in a nil slice. The second return value is false if the
+// list or any of its elements is unknown.
+func StringsFromList(list types.List) ([]string, bool) {
+	if list.IsUnknown() {
+		return nil, false
+	}
+	if list.IsNull() {
+		return nil, true
+	}
+
+	result := make([]string, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		s, ok := elem.(types.String)
+		if !ok || s.IsUnknown() {
+			return nil, false
+		}
+		result = append(result, s.ValueString())
+	}
+	return result, true
+}
+
+// StringsToList converts a slice of strings to a types.List. An empty slice results in a null list.
+func StringsToList(values []string) types.List {
+	if len(values) == 0 {
+		return types.ListNull(types.StringType)
+	}
+	return MustMapToList(values, types.StringType, func(v string) attr.Value {
+		return types.StringValue(v)
+	})
+}
+
+// StringOrNull converts a string to a types.String. An empty string results in a null value.
+func StringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
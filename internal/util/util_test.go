@@ -55,6 +55,14 @@ func TestMustMapToList(t *testing.T) {
 	}
 }
 
+func TestMustMapToSet(t *testing.T) {
+	want := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})
+	got := util.MustMapToSet([]string{"b", "a"}, types.StringType, func(s string) attr.Value { return types.StringValue(s) })
+	if !got.Equal(want) {
+		t.Errorf("MustMapToSet() = %v, want %v", got, want)
+	}
+}
+
 func TestBoolValue(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -177,3 +185,86 @@ func TestInt64Value(t *testing.T) {
 		})
 	}
 }
+
+func TestInt64PointerValue(t *testing.T) {
+	var diags diag.Diagnostics
+
+	value := "42"
+	if got := util.Int64PointerValue(&value, &diags); got != types.Int64Value(42) {
+		t.Errorf("Int64PointerValue() = %v, want %v", got, types.Int64Value(42))
+	}
+	if got := util.Int64PointerValue((*string)(nil), &diags); got != types.Int64Null() {
+		t.Errorf("Int64PointerValue(nil) = %v, want %v", got, types.Int64Null())
+	}
+	if got := util.Int64PointerValue((*int64)(nil), &diags); got != types.Int64Null() {
+		t.Errorf("Int64PointerValue(nil) = %v, want %v", got, types.Int64Null())
+	}
+	if diags.HasError() {
+		t.Errorf("Int64PointerValue() diag = %v, want none", diags)
+	}
+}
+
+func TestStringsFromList(t *testing.T) {
+	tests := []struct {
+		name      string
+		list      types.List
+		want      []string
+		wantKnown bool
+	}{
+		{
+			name:      "null",
+			list:      types.ListNull(types.StringType),
+			want:      nil,
+			wantKnown: true,
+		},
+		{
+			name:      "unknown",
+			list:      types.ListUnknown(types.StringType),
+			want:      nil,
+			wantKnown: false,
+		},
+		{
+			name:      "values",
+			list:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+			want:      []string{"a", "b"},
+			wantKnown: true,
+		},
+		{
+			name:      "unknown element",
+			list:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringUnknown()}),
+			want:      nil,
+			wantKnown: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, known := util.StringsFromList(tt.list)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StringsFromList() = %v, want %v", got, tt.want)
+			}
+			if known != tt.wantKnown {
+				t.Errorf("StringsFromList() known = %v, want %v", known, tt.wantKnown)
+			}
+		})
+	}
+}
+
+func TestStringsToList(t *testing.T) {
+	if got := util.StringsToList(nil); !got.IsNull() {
+		t.Errorf("StringsToList(nil) = %v, want null", got)
+	}
+
+	want := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")})
+	if got := util.StringsToList([]string{"a", "b"}); !got.Equal(want) {
+		t.Errorf("StringsToList() = %v, want %v", got, want)
+	}
+}
+
+func TestStringOrNull(t *testing.T) {
+	if got := util.StringOrNull(""); !got.IsNull() {
+		t.Errorf("StringOrNull(\"\") = %v, want null", got)
+	}
+	if got := util.StringOrNull("a"); !got.Equal(types.StringValue("a")) {
+		t.Errorf("StringOrNull(\"a\") = %v, want \"a\"", got)
+	}
+}
@@ -0,0 +1,53 @@
+package hostnamevalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+var _ validator.String = (*hostnameValidator)(nil)
+
+type hostnameValidator struct{}
+
+// Valid creates a new validator ensuring the value is a fully-qualified host
+// name, such as "ns1.example.com". A trailing dot is allowed.
+func Valid() validator.String {
+	return &hostnameValidator{}
+}
+
+func (v *hostnameValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v *hostnameValidator) MarkdownDescription(_ context.Context) string {
+	return "must be a fully-qualified host name"
+}
+
+func (v *hostnameValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := Validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid host name", fmt.Sprintf("Host name %s.", err))
+	}
+}
+
+// Validate checks that name is a fully-qualified host name consisting of at
+// least two labels of letters, digits and hyphens. The returned error starts
+// with the quoted name.
+func Validate(name string) error {
+	ascii, err := dnsname.ToASCII(name)
+	if err != nil || strings.ContainsAny(ascii, "_*") {
+		return fmt.Errorf("%q is not a valid host name", name)
+	}
+	if !strings.Contains(ascii, ".") {
+		return fmt.Errorf("%q must be fully-qualified, e.g. ns1.example.com", name)
+	}
+	return nil
+}
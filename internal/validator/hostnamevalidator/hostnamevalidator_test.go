@@ -0,0 +1,49 @@
+package hostnamevalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/hostnamevalidator"
+)
+
+func TestHostnameValidator_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          types.String
+		expectError bool
+	}{
+		"null":         {in: types.StringNull()},
+		"unknown":      {in: types.StringUnknown()},
+		"simple":       {in: types.StringValue("ns1.example.com")},
+		"trailing dot": {in: types.StringValue("ns1.example.com.")},
+		"upper case":   {in: types.StringValue("NS1.Example.com")},
+		"empty":        {in: types.StringValue(""), expectError: true},
+		"single label": {in: types.StringValue("localhost"), expectError: true},
+		"underscore":   {in: types.StringValue("_ns.example.com"), expectError: true},
+		"wildcard":     {in: types.StringValue("*.example.com"), expectError: true},
+		"space":        {in: types.StringValue("ns 1.example.com"), expectError: true},
+		"empty label":  {in: types.StringValue("ns1..example.com"), expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{ConfigValue: tc.in}
+			res := validator.StringResponse{}
+			hostnamevalidator.Valid().ValidateString(context.Background(), req, &res)
+
+			if !res.Diagnostics.HasError() && tc.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if res.Diagnostics.HasError() && !tc.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+	}
+}
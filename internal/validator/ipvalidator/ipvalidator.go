@@ -0,0 +1,47 @@
+package ipvalidator
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = (*ipValidator)(nil)
+
+type ipValidator struct{}
+
+// Valid creates a new validator ensuring the value is an IPv4 or IPv6 address
+// without a zone, such as "192.0.2.1" or "2001:db8::1".
+func Valid() validator.String {
+	return &ipValidator{}
+}
+
+func (v *ipValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v *ipValidator) MarkdownDescription(_ context.Context) string {
+	return "must be an IPv4 or IPv6 address"
+}
+
+func (v *ipValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := Validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IP address", fmt.Sprintf("Value %s.", err))
+	}
+}
+
+// Validate checks that value is an IPv4 or IPv6 address without a zone. The
+// returned error starts with the quoted value.
+func Validate(value string) error {
+	addr, err := netip.ParseAddr(value)
+	if err != nil || addr.Zone() != "" {
+		return fmt.Errorf("%q is not a valid IPv4 or IPv6 address", value)
+	}
+	return nil
+}
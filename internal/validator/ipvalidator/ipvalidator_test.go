@@ -0,0 +1,46 @@
+package ipvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/ipvalidator"
+)
+
+func TestIPValidator_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          types.String
+		expectError bool
+	}{
+		"null":      {in: types.StringNull()},
+		"unknown":   {in: types.StringUnknown()},
+		"ipv4":      {in: types.StringValue("192.0.2.1")},
+		"ipv6":      {in: types.StringValue("2001:db8::1")},
+		"empty":     {in: types.StringValue(""), expectError: true},
+		"host name": {in: types.StringValue("ns1.example.com"), expectError: true},
+		"cidr":      {in: types.StringValue("192.0.2.0/24"), expectError: true},
+		"zone":      {in: types.StringValue("fe80::1%eth0"), expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{ConfigValue: tc.in}
+			res := validator.StringResponse{}
+			ipvalidator.Valid().ValidateString(context.Background(), req, &res)
+
+			if !res.Diagnostics.HasError() && tc.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if res.Diagnostics.HasError() && !tc.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package subdomainvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+var _ validator.String = (*subdomainValidator)(nil)
+
+type subdomainValidator struct{}
+
+// Valid creates a new validator ensuring the value is a subdomain relative to its
+// domain, such as "www" or "_dmarc". Empty values refer to the domain itself, and
+// a wildcard is only allowed as the first label.
+func Valid() validator.String {
+	return &subdomainValidator{}
+}
+
+func (v *subdomainValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v *subdomainValidator) MarkdownDescription(_ context.Context) string {
+	return "must be empty or a relative domain name, optionally starting with a wildcard label"
+}
+
+func (v *subdomainValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := Validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid subdomain", fmt.Sprintf("Subdomain %s.", err))
+	}
+}
+
+// Validate checks that subdomain is empty or a relative domain name. The
+// returned error starts with the quoted subdomain.
+func Validate(subdomain string) error {
+	if subdomain == "" {
+		return nil
+	}
+	if strings.HasSuffix(subdomain, ".") {
+		return fmt.Errorf("%q must be relative to the domain and not end with a dot", subdomain)
+	}
+	if _, err := dnsname.ToASCII(subdomain); err != nil {
+		return fmt.Errorf("%q is not a valid domain name", subdomain)
+	}
+	if i := strings.LastIndex(subdomain, "*"); i > 0 {
+		return fmt.Errorf("%q may only contain a wildcard as its first label", subdomain)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package subdomainvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/subdomainvalidator"
+)
+
+func TestSubdomainValidator_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          types.String
+		expectError bool
+	}{
+		"null":           {in: types.StringNull()},
+		"unknown":        {in: types.StringUnknown()},
+		"apex":           {in: types.StringValue("")},
+		"simple":         {in: types.StringValue("www")},
+		"nested":         {in: types.StringValue("api.eu")},
+		"underscore":     {in: types.StringValue("_dmarc")},
+		"wildcard":       {in: types.StringValue("*.dev")},
+		"trailing dot":   {in: types.StringValue("www."), expectError: true},
+		"empty label":    {in: types.StringValue("a..b"), expectError: true},
+		"inner wildcard": {in: types.StringValue("dev.*"), expectError: true},
+		"space":          {in: types.StringValue("my host"), expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{ConfigValue: tc.in}
+			res := validator.StringResponse{}
+			subdomainvalidator.Valid().ValidateString(context.Background(), req, &res)
+
+			if !res.Diagnostics.HasError() && tc.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if res.Diagnostics.HasError() && !tc.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+	}
+}
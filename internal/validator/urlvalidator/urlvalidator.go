@@ -0,0 +1,57 @@
+package urlvalidator
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = (*httpValidator)(nil)
+
+type httpValidator struct{}
+
+// HTTP creates a new validator ensuring the value is an absolute http or https
+// URL with a host, such as "https://example.com/path".
+func HTTP() validator.String {
+	return &httpValidator{}
+}
+
+func (v *httpValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v *httpValidator) MarkdownDescription(_ context.Context) string {
+	return "must be an absolute http or https URL"
+}
+
+func (v *httpValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := ValidateHTTP(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid URL", fmt.Sprintf("URL %s.", err))
+	}
+}
+
+// ValidateHTTP checks that location is an absolute http or https URL with a
+// host. The returned error starts with the quoted location.
+func ValidateHTTP(location string) error {
+	u, err := url.Parse(location)
+	if err != nil {
+		return fmt.Errorf("%q could not be parsed: %w", location, err)
+	}
+	if scheme := strings.ToLower(u.Scheme); scheme != "http" && scheme != "https" {
+		return fmt.Errorf("%q must be absolute and start with http:// or https://", location)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("%q must include a host", location)
+	}
+	if strings.ContainsAny(location, " \t\r\n") {
+		return fmt.Errorf("%q must not contain whitespace", location)
+	}
+	return nil
+}
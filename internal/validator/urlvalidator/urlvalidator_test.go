@@ -0,0 +1,50 @@
+package urlvalidator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/validator/urlvalidator"
+)
+
+func TestHTTPValidator_ValidateString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		in          types.String
+		expectError bool
+	}{
+		"null":           {in: types.StringNull()},
+		"unknown":        {in: types.StringUnknown()},
+		"https":          {in: types.StringValue("https://example.com")},
+		"http with path": {in: types.StringValue("http://example.com/path?q=1")},
+		"upper scheme":   {in: types.StringValue("HTTPS://example.com")},
+		"port":           {in: types.StringValue("https://example.com:8443/")},
+		"empty":          {in: types.StringValue(""), expectError: true},
+		"bare host":      {in: types.StringValue("example.com"), expectError: true},
+		"relative":       {in: types.StringValue("/path"), expectError: true},
+		"other scheme":   {in: types.StringValue("ftp://example.com"), expectError: true},
+		"missing host":   {in: types.StringValue("https:///path"), expectError: true},
+		"whitespace":     {in: types.StringValue("https://example.com/a b"), expectError: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := validator.StringRequest{ConfigValue: tc.in}
+			res := validator.StringResponse{}
+			urlvalidator.HTTP().ValidateString(context.Background(), req, &res)
+
+			if !res.Diagnostics.HasError() && tc.expectError {
+				t.Fatal("expected error, got no error")
+			}
+			if res.Diagnostics.HasError() && !tc.expectError {
+				t.Fatalf("got unexpected error: %s", res.Diagnostics)
+			}
+		})
+	}
+}
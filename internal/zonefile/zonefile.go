@@ -0,0 +1,106 @@
+// Package zonefile converts RFC 1035 master files into DNS records as managed by Porkbun.
+package zonefile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/miekg/dns"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/dnsname"
+)
+
+// MinTTL is the minimum TTL accepted by Porkbun. Lower TTLs are raised to it.
+const MinTTL = 600
+
+// Record is a DNS record in the format used by the Porkbun API.
+type Record struct {
+	// Subdomain is the name of the record relative to the origin. It is empty for the origin itself.
+	Subdomain string
+	Type      string
+	// Content is the RDATA of the record, excluding the priority of MX and SRV records.
+	Content string
+	TTL     int64
+	// Prio is the priority of MX and SRV records, and zero for all other types.
+	Prio int64
+}
+
+// Parse parses a master file (RFC 1035, section 5) whose records belong to
+// the domain origin. SOA records and NS records of the origin itself are skipped,
+// as they are managed by Porkbun. Records of types Porkbun does not support and
+// records outside the origin are reported as errors.
+func Parse(text, origin string) ([]Record, error) {
+	domain, err := dnsname.ToASCII(origin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid origin: %w", err)
+	}
+
+	zp := dns.NewZoneParser(strings.NewReader(text), dns.Fqdn(domain), "")
+	zp.SetDefaultTTL(MinTTL)
+
+	var records []Record
+	var errs []error
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		hdr := rr.Header()
+		subdomain, ok := dnsname.Subdomain(hdr.Name, domain)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s %s: name is not within %s", hdr.Name, dns.TypeToString[hdr.Rrtype], domain))
+			continue
+		}
+		if hdr.Class != dns.ClassINET {
+			errs = append(errs, fmt.Errorf("%s %s: unsupported class %s", hdr.Name, dns.TypeToString[hdr.Rrtype], dns.ClassToString[hdr.Class]))
+			continue
+		}
+
+		record := Record{
+			Subdomain: subdomain,
+			Type:      dns.TypeToString[hdr.Rrtype],
+			TTL:       max(int64(hdr.Ttl), MinTTL),
+		}
+		switch rr := rr.(type) {
+		case *dns.SOA:
+			continue
+		case *dns.NS:
+			if subdomain == "" {
+				continue
+			}
+			record.Content = trimDot(rr.Ns)
+		case *dns.A:
+			record.Content = rr.A.String()
+		case *dns.AAAA:
+			record.Content = rr.AAAA.String()
+		case *dns.CNAME:
+			record.Content = trimDot(rr.Target)
+		case *dns.MX:
+			record.Prio = int64(rr.Preference)
+			record.Content = trimDot(rr.Mx)
+		case *dns.SRV:
+			record.Prio = int64(rr.Priority)
+			record.Content = fmt.Sprintf("%d %d %s", rr.Weight, rr.Port, trimDot(rr.Target))
+		case *dns.TXT:
+			record.Content = strings.Join(rr.Txt, "")
+		case *dns.TLSA, *dns.CAA, *dns.HTTPS, *dns.SVCB:
+			record.Content = strings.TrimPrefix(rr.String(), hdr.String())
+		default:
+			errs = append(errs, fmt.Errorf("%s %s: record type is not supported by Porkbun", hdr.Name, record.Type))
+			continue
+		}
+		records = append(records, record)
+	}
+	if err := zp.Err(); err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return records, nil
+}
+
+// trimDot removes the trailing dot of a fully-qualified name. The root name "." is kept.
+func trimDot(name string) string {
+	if name == "." {
+		return name
+	}
+	return strings.TrimSuffix(name, ".")
+}
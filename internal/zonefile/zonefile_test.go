@@ -0,0 +1,74 @@
+package zonefile_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/marcfrederick/terraform-provider-porkbun/internal/zonefile"
+)
+
+const testZone = `$ORIGIN example.com.
+$TTL 3600
+@       IN SOA ns1.example.net. hostmaster.example.com. (
+            2024010101 ; serial
+            7200       ; refresh
+            3600       ; retry
+            1209600    ; expire
+            300 )      ; minimum
+@       IN NS    ns1.example.net.
+@          A     192.0.2.1
+www     60 IN AAAA 2001:db8::1
+blog       CNAME www
+@          MX    10 mail.example.com.
+@          TXT   ( "v=spf1 include:_spf.example.net"
+                   " ~all" )
+_sip._tcp  SRV   5 10 5060 sip
+*.dev      A     192.0.2.2
+@          CAA   0 issue "letsencrypt.org"
+$ORIGIN sub.example.com.
+api        A     192.0.2.3
+delegated  NS    ns1.example.org.
+`
+
+func TestParse(t *testing.T) {
+	got, err := zonefile.Parse(testZone, "example.com")
+	if err != nil {
+		t.Fatalf("Parse() unexpected error: %v", err)
+	}
+
+	want := []zonefile.Record{
+		{Subdomain: "", Type: "A", Content: "192.0.2.1", TTL: 3600},
+		{Subdomain: "www", Type: "AAAA", Content: "2001:db8::1", TTL: 600},
+		{Subdomain: "blog", Type: "CNAME", Content: "www.example.com", TTL: 3600},
+		{Subdomain: "", Type: "MX", Content: "mail.example.com", TTL: 3600, Prio: 10},
+		{Subdomain: "", Type: "TXT", Content: "v=spf1 include:_spf.example.net ~all", TTL: 3600},
+		{Subdomain: "_sip._tcp", Type: "SRV", Content: "10 5060 sip.example.com", TTL: 3600, Prio: 5},
+		{Subdomain: "*.dev", Type: "A", Content: "192.0.2.2", TTL: 3600},
+		{Subdomain: "", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 3600},
+		{Subdomain: "api.sub", Type: "A", Content: "192.0.2.3", TTL: 3600},
+		{Subdomain: "delegated.sub", Type: "NS", Content: "ns1.example.org", TTL: 3600},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Parse() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		origin string
+	}{
+		{"syntax error", "www IN A not-an-address\n", "example.com"},
+		{"unsupported type", "@ IN PTR host.example.com.\n", "example.com"},
+		{"outside origin", "www.example.org. IN A 192.0.2.1\n", "example.com"},
+		{"invalid origin", "@ IN A 192.0.2.1\n", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := zonefile.Parse(tt.text, tt.origin); err == nil {
+				t.Error("Parse() expected an error")
+			}
+		})
+	}
+}